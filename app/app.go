@@ -188,6 +188,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		wasm.ModuleName:                {authtypes.Burner},
+		dustBurnerModuleName:           {authtypes.Burner},
 	}
 )
 
@@ -222,6 +223,11 @@ type App struct {
 	cudosMigrationConfigPath   string
 	cudosMigrationConfigSha256 string
 
+	cudosReconciliationDataPath   string
+	cudosReconciliationDataSha256 string
+
+	networkMergeAuditOverride bool
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -261,7 +267,7 @@ type App struct {
 // NewSimApp returns a reference to an initialized SimApp.
 func New(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
-	skipUpgradeHeights map[int64]bool, homePath string, invCheckPeriod uint, cudosGenesisPath string, cudosMigrationConfigPath string, cudosGenesisSha256 string, cudosMigrationConfigSha256 string, encodingConfig appparams.EncodingConfig, enabledProposals []wasm.ProposalType,
+	skipUpgradeHeights map[int64]bool, homePath string, invCheckPeriod uint, cudosGenesisPath string, cudosMigrationConfigPath string, cudosGenesisSha256 string, cudosMigrationConfigSha256 string, cudosReconciliationDataPath string, cudosReconciliationDataSha256 string, encodingConfig appparams.EncodingConfig, enabledProposals []wasm.ProposalType,
 	appOpts servertypes.AppOptions, wasmOpts []wasm.Option, baseAppOptions ...func(*baseapp.BaseApp),
 ) *App {
 
@@ -285,18 +291,21 @@ func New(
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
-		BaseApp:                    bApp,
-		legacyAmino:                legacyAmino,
-		appCodec:                   appCodec,
-		interfaceRegistry:          interfaceRegistry,
-		invCheckPeriod:             invCheckPeriod,
-		cudosGenesisPath:           cudosGenesisPath,
-		cudosGenesisSha256:         cudosGenesisSha256,
-		cudosMigrationConfigPath:   cudosMigrationConfigPath,
-		cudosMigrationConfigSha256: cudosMigrationConfigSha256,
-		keys:                       keys,
-		tkeys:                      tkeys,
-		memKeys:                    memKeys,
+		BaseApp:                       bApp,
+		legacyAmino:                   legacyAmino,
+		appCodec:                      appCodec,
+		interfaceRegistry:             interfaceRegistry,
+		invCheckPeriod:                invCheckPeriod,
+		cudosGenesisPath:              cudosGenesisPath,
+		cudosGenesisSha256:            cudosGenesisSha256,
+		cudosMigrationConfigPath:      cudosMigrationConfigPath,
+		cudosMigrationConfigSha256:    cudosMigrationConfigSha256,
+		cudosReconciliationDataPath:   cudosReconciliationDataPath,
+		cudosReconciliationDataSha256: cudosReconciliationDataSha256,
+		networkMergeAuditOverride:     cast.ToBool(appOpts.Get(AppOptNetworkMergeAuditOverride)),
+		keys:                          keys,
+		tkeys:                         tkeys,
+		memKeys:                       memKeys,
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -748,14 +757,40 @@ func getNetworkInfo(app *App, ctx sdk.Context, manifest *UpgradeManifest, expect
 
 		manifest.NetworkConfigFileSha256 = app.cudosMigrationConfigSha256
 
-		// Config file not given, config from hardcoded map
-	} else if info, ok := NetworkInfos[ctx.ChainID()]; ok {
-		app.Logger().Info("cudos merge: loading network from map", "chain", ctx.ChainID())
-		manifest.NetworkConfigFileSha256 = "config map"
-		networkInfo = &info
-
+		// Config file not given, config from built-in registry
 	} else {
-		return nil, fmt.Errorf("network info not found for chain id: %s", ctx.ChainID())
+		var configSha256 string
+		networkInfo, configSha256, err = LoadBuiltinNetworkConfig(ctx.ChainID())
+		if err != nil {
+			return nil, err
+		}
+		app.Logger().Info("cudos merge: loading built-in network config", "chain", ctx.ChainID(), "sha256", configSha256)
+
+		// Built-in config may still contain values to be filled before the upgrade
+		err = VerifyNoPlaceholders(networkInfo)
+		if err != nil {
+			return nil, fmt.Errorf("built-in network config of chain id %s: %w", ctx.ChainID(), err)
+		}
+
+		if networkInfo.MergeSourceChainID != "" && networkInfo.MergeSourceChainID != expectedChainIdOfMergeSourceGenesis {
+			return nil, fmt.Errorf("mismatch of Merge Source ChainID: the \"merge_source_chain_id\" value in the built-in NetworkConfig contains \"%s\", expected value is chan-id from input merge source genesis json file, which is \"%s\"", networkInfo.MergeSourceChainID, expectedChainIdOfMergeSourceGenesis)
+		}
+
+		manifest.NetworkConfigFileSha256 = configSha256
+	}
+
+	// Reconciliation data given on command line replaces data embedded in the binary
+	if app.cudosReconciliationDataPath != "" {
+		if networkInfo.ReconciliationInfo == nil {
+			return nil, fmt.Errorf("reconciliation data file is given, but network config has no reconciliation info")
+		}
+
+		app.Logger().Info("cudos merge: loading reconciliation data", "file", app.cudosReconciliationDataPath, "expected sha256", app.cudosReconciliationDataSha256)
+
+		err = networkInfo.ReconciliationInfo.SetInputCSVFile(app.cudosReconciliationDataPath, app.cudosReconciliationDataSha256)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return networkInfo, nil
@@ -788,6 +823,7 @@ func (app *App) RegisterUpgradeHandlers(cfg module.Configurator) {
 	app.UpgradeKeeper.SetUpgradeHandler("v0.14.0", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 
 		manifest := NewUpgradeManifest()
+		getLedger(manifest).SetBalanceSource(app.getLedgerAccountBalance(ctx))
 
 		cudosGenesisData, networkInfo, err := LoadAndParseMergeSourceInputFiles(app, ctx, manifest)
 		if err != nil {
@@ -819,6 +855,16 @@ func (app *App) RegisterUpgradeHandlers(cfg module.Configurator) {
 			return nil, err
 		}
 
+		err = app.ApplyContractOperations(ctx, networkInfo, manifest)
+		if err != nil {
+			return nil, err
+		}
+
+		err = app.MigrateContracts(ctx, networkInfo, manifest)
+		if err != nil {
+			return nil, err
+		}
+
 		err = app.ProcessReconciliation(ctx, networkInfo, manifest)
 		if err != nil {
 			return nil, err
@@ -836,11 +882,21 @@ func (app *App) RegisterUpgradeHandlers(cfg module.Configurator) {
 			return nil, err
 		}
 
+		auditErr := app.AuditNetworkMerge(ctx, cudosConfig, manifest)
+
 		err = SaveManifest(app, manifest, plan.Name)
 		if err != nil {
 			return nil, err
 		}
 
+		if auditErr != nil {
+			if !app.networkMergeAuditOverride {
+				// Halt all nodes at the upgrade height instead of committing unexpected state
+				panic(fmt.Errorf("cudos merge: %w, set \"%s = true\" in app.toml to proceed", auditErr, AppOptNetworkMergeAuditOverride))
+			}
+			app.Logger().Error("cudos merge: audit failed, proceeding due to operator override", "report", auditErr.Error())
+		}
+
 		// End of migration
 		return app.mm.RunMigrations(ctx, cfg, fromVM)
 	})
@@ -0,0 +1,255 @@
+package app
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractStateRecord is a single KV pair of contract storage, as dumped and loaded by `fetchd util contract` commands.
+// Key is always hex encoded, namespace and map key are informative only and decoded from the key where possible.
+type ContractStateRecord struct {
+	Key       string          `json:"key"`
+	Namespace string          `json:"namespace,omitempty"`
+	MapKey    string          `json:"map_key,omitempty"`     // Printable remainder of the key after namespace
+	MapKeyHex string          `json:"map_key_hex,omitempty"` // Remainder of the key after namespace
+	Value     json.RawMessage `json:"value,omitempty"`       // Set if value is valid JSON
+	ValueHex  string          `json:"value_hex,omitempty"`   // Set if value is not valid JSON
+	Delete    bool            `json:"delete,omitempty"`      // Only for loading, removes the key
+}
+
+func isPrintable(val []byte) bool {
+	if len(val) == 0 {
+		return false
+	}
+	for _, c := range val {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeContractStateKey splits key into cw-storage-plus namespace and map key if possible
+func decodeContractStateKey(key []byte) (namespace string, mapKey []byte) {
+	if isPrintable(key) {
+		// Item
+		return string(key), nil
+	}
+
+	if len(key) < 2 {
+		return "", nil
+	}
+	namespaceLen := int(binary.BigEndian.Uint16(key[:2]))
+	if len(key) < 2+namespaceLen || !isPrintable(key[2:2+namespaceLen]) {
+		return "", nil
+	}
+	return string(key[2 : 2+namespaceLen]), key[2+namespaceLen:]
+}
+
+func NewContractStateRecord(key []byte, value []byte) ContractStateRecord {
+	record := ContractStateRecord{
+		Key: hex.EncodeToString(key),
+	}
+
+	namespace, mapKey := decodeContractStateKey(key)
+	record.Namespace = namespace
+	if len(mapKey) > 0 {
+		record.MapKeyHex = hex.EncodeToString(mapKey)
+		if isPrintable(mapKey) {
+			record.MapKey = string(mapKey)
+		}
+	}
+
+	if json.Valid(value) {
+		record.Value = append(json.RawMessage{}, value...)
+	} else {
+		record.ValueHex = hex.EncodeToString(value)
+	}
+
+	return record
+}
+
+func (r *ContractStateRecord) RawKey() ([]byte, error) {
+	return decodeHexField("key", r.Key)
+}
+
+func (r *ContractStateRecord) RawValue() ([]byte, error) {
+	switch {
+	case r.Delete:
+		return nil, nil
+	case len(r.Value) > 0 && r.ValueHex != "":
+		return nil, fmt.Errorf("only one of value and value_hex can be set")
+	case len(r.Value) > 0:
+		return compactJSONValue(r.Value)
+	default:
+		return decodeHexField("value_hex", r.ValueHex)
+	}
+}
+
+// IterateContractState iterates raw contract storage in wasm store using the same prefix layout as getContractData
+func IterateContractState(wasmStore types.KVStore, contractAddr string, cb func(key []byte, value []byte) error) error {
+	addr, err := types.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return fmt.Errorf("invalid contract address: %v", err)
+	}
+	if !wasmStore.Has(wasmTypes.GetContractAddressKey(addr)) {
+		return fmt.Errorf("contract %s does not exist", contractAddr)
+	}
+
+	prefixStore := prefix.NewStore(wasmStore, wasmTypes.GetContractStorePrefix(addr))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := cb(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type kvChange struct {
+	key   []byte
+	value []byte // nil means deleted
+}
+
+// diffStores returns changes which turn `from` store into `to` store
+func diffStores(from types.KVStore, to types.KVStore) []kvChange {
+	var changes []kvChange
+
+	fromIter := from.Iterator(nil, nil)
+	defer fromIter.Close()
+	toIter := to.Iterator(nil, nil)
+	defer toIter.Close()
+
+	for fromIter.Valid() || toIter.Valid() {
+		var cmp int
+		switch {
+		case !fromIter.Valid():
+			cmp = 1
+		case !toIter.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(fromIter.Key(), toIter.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			changes = append(changes, kvChange{key: types.CopyBytes(fromIter.Key())})
+			fromIter.Next()
+		case cmp > 0:
+			changes = append(changes, kvChange{key: types.CopyBytes(toIter.Key()), value: types.CopyBytes(toIter.Value())})
+			toIter.Next()
+		default:
+			if !bytes.Equal(fromIter.Value(), toIter.Value()) {
+				changes = append(changes, kvChange{key: types.CopyBytes(toIter.Key()), value: types.CopyBytes(toIter.Value())})
+			}
+			fromIter.Next()
+			toIter.Next()
+		}
+	}
+
+	return changes
+}
+
+// OverwriteContractStateAtLatestHeight writes records to contract storage and re-commits the latest height in place, so the
+// node stays at the same height as its block store. Wasm store is rolled back to the previous version, changes of the latest
+// block are re-applied together with the records, and commit info of the latest height is rewritten. Resulting app hash
+// differs from the one agreed by the network, so this is meant only for nodes which are not going to rejoin it, e.g. local forks.
+// Node must be stopped. If `replace` is set, whole contract storage is removed before the records are written.
+func (app *App) OverwriteContractStateAtLatestHeight(contractAddr string, records []ContractStateRecord, replace bool) (int64, error) {
+	addr, err := types.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid contract address: %v", err)
+	}
+
+	cms := app.CommitMultiStore()
+	version := cms.LastCommitID().Version
+	if version < 2 {
+		return 0, fmt.Errorf("latest height %d is too low to be overwritten", version)
+	}
+
+	wasmStore, isIAVL := cms.GetCommitKVStore(app.keys[wasmTypes.StoreKey]).(*iavl.Store)
+	if !isIAVL {
+		return 0, fmt.Errorf("wasm store is not IAVL store")
+	}
+	if !wasmStore.VersionExists(version - 1) {
+		return 0, fmt.Errorf("previous version %d of wasm store has been pruned", version-1)
+	}
+	if !wasmStore.Has(wasmTypes.GetContractAddressKey(addr)) {
+		return 0, fmt.Errorf("contract %s does not exist", contractAddr)
+	}
+
+	// Validate all records before anything is written
+	keys := make([][]byte, len(records))
+	values := make([][]byte, len(records))
+	for i := range records {
+		if keys[i], err = records[i].RawKey(); err != nil {
+			return 0, fmt.Errorf("record %d: %w", i, err)
+		}
+		if values[i], err = records[i].RawValue(); err != nil {
+			return 0, fmt.Errorf("record %d: %w", i, err)
+		}
+	}
+
+	prevStore, err := wasmStore.GetImmutable(version - 1)
+	if err != nil {
+		return 0, err
+	}
+	latestStore, err := wasmStore.GetImmutable(version)
+	if err != nil {
+		return 0, err
+	}
+	lastBlockChanges := diffStores(prevStore, latestStore)
+
+	if _, err = wasmStore.LoadVersionForOverwriting(version - 1); err != nil {
+		return 0, fmt.Errorf("failed to load previous version of wasm store: %w", err)
+	}
+
+	for _, change := range lastBlockChanges {
+		if change.value == nil {
+			wasmStore.Delete(change.key)
+		} else {
+			wasmStore.Set(change.key, change.value)
+		}
+	}
+
+	contractStore := prefix.NewStore(wasmStore, wasmTypes.GetContractStorePrefix(addr))
+	if replace {
+		var existingKeys [][]byte
+		iter := contractStore.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			existingKeys = append(existingKeys, types.CopyBytes(iter.Key()))
+		}
+		iter.Close()
+		for _, key := range existingKeys {
+			contractStore.Delete(key)
+		}
+	}
+
+	for i := range records {
+		if records[i].Delete {
+			contractStore.Delete(keys[i])
+		} else {
+			contractStore.Set(keys[i], values[i])
+		}
+	}
+
+	if commitID := wasmStore.Commit(); commitID.Version != version {
+		return 0, fmt.Errorf("wasm store was committed at version %d instead of %d", commitID.Version, version)
+	}
+
+	// Rewrites commit info of the latest height with the new wasm store hash
+	if err = cms.RollbackToVersion(version); err != nil {
+		return 0, fmt.Errorf("failed to rewrite commit info: %w", err)
+	}
+
+	return version, nil
+}
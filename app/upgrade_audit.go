@@ -0,0 +1,173 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"strings"
+)
+
+const (
+	// AppOptNetworkMergeAuditOverride is app.toml option which allows node to proceed with the upgrade even if the audit fails
+	AppOptNetworkMergeAuditOverride = "network-merge.audit-override"
+)
+
+var DefaultMaxToleratedSupplyBreakdownDifference, _ = sdk.NewIntFromString("10000000000")
+
+type UpgradeAuditConfigJSON struct {
+	MaxToleratedSupplyBreakdownDifference *sdk.Int `json:"max_supply_breakdown_difference,omitempty"` // Absolute difference tolerated on each supply breakdown line
+	SkipInvariants                        bool     `json:"skip_invariants,omitempty"`                 // Do not run registered module invariants as part of the audit
+}
+
+type UpgradeAuditCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Details string `json:"details,omitempty"`
+}
+
+type UpgradeAudit struct {
+	Passed     bool                `json:"passed"`
+	Overridden bool                `json:"overridden"`
+	Checks     []UpgradeAuditCheck `json:"checks"`
+}
+
+func (audit *UpgradeAudit) addCheck(name string, err error) {
+	check := UpgradeAuditCheck{
+		Name:   name,
+		Passed: err == nil,
+	}
+	if err != nil {
+		check.Details = err.Error()
+		audit.Passed = false
+	}
+	audit.Checks = append(audit.Checks, check)
+}
+
+// Report returns human-readable list of failed checks
+func (audit *UpgradeAudit) Report() string {
+	var failed []string
+	for _, check := range audit.Checks {
+		if !check.Passed {
+			failed = append(failed, fmt.Sprintf("  - %s: %s", check.Name, check.Details))
+		}
+	}
+	if len(failed) == 0 {
+		return "network merge audit passed"
+	}
+	return fmt.Sprintf("network merge audit failed %d of %d checks:\n%s", len(failed), len(audit.Checks), strings.Join(failed, "\n"))
+}
+
+func getAuditConfig(cudosCfg *CudosMergeConfig) *UpgradeAuditConfigJSON {
+	if cudosCfg.Config.Audit == nil {
+		return &UpgradeAuditConfigJSON{}
+	}
+	return cudosCfg.Config.Audit
+}
+
+func auditSupplyBreakdown(manifest *UpgradeManifest, maxToleratedDifference sdk.Int) error {
+	if manifest.SupplyBreakdown == nil {
+		return fmt.Errorf("supply breakdown is missing in manifest")
+	}
+
+	var exceeded []string
+	for _, lines := range [][]*UpgradeSupplyBreakdownLine{manifest.SupplyBreakdown.Source, manifest.SupplyBreakdown.Destination} {
+		for _, line := range lines {
+			if line.Difference != nil && line.Difference.Abs().GT(maxToleratedDifference) {
+				exceeded = append(exceeded, fmt.Sprintf("%s %s differs by %s", line.Name, line.Denom, line.Difference.String()))
+			}
+		}
+	}
+
+	if len(exceeded) > 0 {
+		return fmt.Errorf("difference is higher than %s: %s", maxToleratedDifference.String(), strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+func auditDestinationSupply(manifest *UpgradeManifest) error {
+	if manifest.SupplyVerification == nil || manifest.Ledger == nil {
+		return fmt.Errorf("supply verification or ledger is missing in manifest")
+	}
+
+	supplyIncrease, isNegative := manifest.SupplyVerification.DestinationSupplyAfter.SafeSub(manifest.SupplyVerification.DestinationSupplyBefore)
+	if isNegative {
+		return fmt.Errorf("destination supply decreased from %s to %s", manifest.SupplyVerification.DestinationSupplyBefore.String(), manifest.SupplyVerification.DestinationSupplyAfter.String())
+	}
+
+	// Retired amount, e.g. burned dust, was issued first and then removed from the supply
+	issued := manifest.Ledger.IssuedAmount(LedgerDomainDestinationBank)
+	retired := manifest.Ledger.RetiredAmount(LedgerDomainDestinationBank)
+	outstanding, isNegative := issued.SafeSub(retired)
+	if isNegative {
+		return fmt.Errorf("ledger recorded %s as retired, which is more than issued %s", retired.String(), issued.String())
+	}
+	if !isEqualCoins(supplyIncrease, outstanding) {
+		return fmt.Errorf("destination supply increased by %s, but ledger recorded %s as issued and %s as retired", supplyIncrease.String(), issued.String(), retired.String())
+	}
+	return nil
+}
+
+// getLedgerAccountBalance returns destination bank balance of ledger account, which is either bech32 account address or module name
+func (app *App) getLedgerAccountBalance(ctx sdk.Context) func(account string) (sdk.Coins, error) {
+	return func(account string) (sdk.Coins, error) {
+		address, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			address = app.AccountKeeper.GetModuleAddress(account)
+			if address == nil {
+				return nil, fmt.Errorf("account %s is neither address nor module account", account)
+			}
+		}
+		return app.BankKeeper.GetAllBalances(ctx, address), nil
+	}
+}
+
+func (app *App) auditInvariants(ctx sdk.Context) error {
+	var broken []string
+	for _, route := range app.CrisisKeeper.Routes() {
+		msg, isBroken := route.Invar(ctx)
+		if isBroken {
+			broken = append(broken, fmt.Sprintf("%s: %s", route.FullRoute(), msg))
+		}
+	}
+
+	if len(broken) > 0 {
+		return fmt.Errorf("broken invariants: %s", strings.Join(broken, "; "))
+	}
+	return nil
+}
+
+// AuditNetworkMerge runs post-upgrade self-audit and records its result in the manifest. Returned error means that at least one check failed.
+func (app *App) AuditNetworkMerge(ctx sdk.Context, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+	auditCfg := getAuditConfig(cudosCfg)
+	maxToleratedDifference := unwrapOrDefault(auditCfg.MaxToleratedSupplyBreakdownDifference, DefaultMaxToleratedSupplyBreakdownDifference)
+
+	audit := &UpgradeAudit{Passed: true}
+	manifest.Audit = audit
+
+	audit.addCheck("supply_breakdown", auditSupplyBreakdown(manifest, maxToleratedDifference))
+	audit.addCheck("destination_supply", auditDestinationSupply(manifest))
+
+	if manifest.Ledger != nil {
+		audit.addCheck("ledger", manifest.Ledger.Verify())
+		audit.addCheck("ledger_balances", manifest.Ledger.VerifyBalances())
+	} else {
+		audit.addCheck("ledger", fmt.Errorf("ledger is missing in manifest"))
+	}
+
+	for _, toleranceCheck := range manifest.ToleranceChecks {
+		if !toleranceCheck.Passed {
+			audit.addCheck("tolerance_"+toleranceCheck.Name, fmt.Errorf("balance %s is higher than %s", toleranceCheck.Balance, toleranceCheck.MaxTolerated.String()))
+		}
+	}
+
+	if !auditCfg.SkipInvariants {
+		audit.addCheck("invariants", app.auditInvariants(ctx))
+	}
+
+	if audit.Passed {
+		return nil
+	}
+
+	audit.Overridden = app.networkMergeAuditOverride
+	return errors.New(audit.Report())
+}
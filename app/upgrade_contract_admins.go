@@ -0,0 +1,213 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	OwnerStorageCwOwnable      = "cw_ownable"
+	OwnerStorageCwControllers  = "cw_controllers_admin"
+	ContractAdminKindWasmAdmin = "wasm_admin"
+
+	cwOwnableDefaultKey     = "ownership"
+	cwControllersDefaultKey = "admin"
+)
+
+// ContractAdminRotation changes wasmd admin of any contract and optionally owner stored in its own state
+type ContractAdminRotation struct {
+	Contract      string  `json:"contract"`
+	ExpectedAdmin *string `json:"expected_admin,omitempty"` // wasmd admin the contract must have before the rotation, empty string means no admin
+	NewAdmin      *string `json:"new_admin,omitempty"`      // wasmd admin, empty string clears the admin
+	NewOwner      *string `json:"new_owner,omitempty"`      // Owner stored in contract state, empty string clears the owner
+	OwnerStorage  string  `json:"owner_storage,omitempty"`  // One of cw_ownable, cw_controllers_admin, required if new owner is set
+	OwnerItemKey  string  `json:"owner_item_key,omitempty"` // Overrides default item key of the owner storage
+}
+
+// cwOwnableOwnership mirrors `Ownership` item of cw-ownable
+type cwOwnableOwnership struct {
+	Owner         *string         `json:"owner"`
+	PendingOwner  *string         `json:"pending_owner"`
+	PendingExpiry json.RawMessage `json:"pending_expiry"`
+}
+
+func (r *ContractAdminRotation) ownerItemKey() string {
+	if r.OwnerItemKey != "" {
+		return r.OwnerItemKey
+	}
+	if r.OwnerStorage == OwnerStorageCwOwnable {
+		return cwOwnableDefaultKey
+	}
+	return cwControllersDefaultKey
+}
+
+func (r *ContractAdminRotation) Validate() error {
+	if err := verifyAddress(r.Contract, nil); err != nil {
+		return fmt.Errorf("contract: %w", err)
+	}
+	if r.NewAdmin == nil && r.NewOwner == nil {
+		return fmt.Errorf("neither new_admin nor new_owner is set")
+	}
+	if r.ExpectedAdmin != nil && *r.ExpectedAdmin != "" {
+		if err := verifyAddress(*r.ExpectedAdmin, nil); err != nil {
+			return fmt.Errorf("expected_admin: %w", err)
+		}
+	}
+	if r.NewAdmin != nil && *r.NewAdmin != "" {
+		if err := verifyAddress(*r.NewAdmin, nil); err != nil {
+			return fmt.Errorf("new_admin: %w", err)
+		}
+	}
+	if r.NewOwner == nil {
+		return nil
+	}
+	if *r.NewOwner != "" {
+		if err := verifyAddress(*r.NewOwner, nil); err != nil {
+			return fmt.Errorf("new_owner: %w", err)
+		}
+	}
+	switch r.OwnerStorage {
+	case OwnerStorageCwOwnable, OwnerStorageCwControllers:
+	default:
+		return fmt.Errorf("unknown owner storage \"%s\"", r.OwnerStorage)
+	}
+	return nil
+}
+
+func ValidateContractAdminRotations(rotations []ContractAdminRotation) error {
+	for i := range rotations {
+		if err := rotations[i].Validate(); err != nil {
+			return fmt.Errorf("contract admin rotation %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func optionalAddrToString(addr *string) string {
+	if addr == nil {
+		return ""
+	}
+	return *addr
+}
+
+// rewriteContractOwner replaces owner item in contract storage and returns the original owner
+func (app *App) rewriteContractOwner(ctx types.Context, rotation *ContractAdminRotation) (string, error) {
+	_, _, prefixStore, err := app.getContractData(ctx, rotation.Contract)
+	if err != nil {
+		return "", err
+	}
+
+	key := []byte(rotation.ownerItemKey())
+	storeVal := prefixStore.Get(key)
+	if storeVal == nil {
+		return "", fmt.Errorf("owner item \"%s\" does not exist in contract %s storage", string(key), rotation.Contract)
+	}
+
+	var newOwner *string
+	if *rotation.NewOwner != "" {
+		newOwner = rotation.NewOwner
+	}
+
+	var oldOwner *string
+	var newVal []byte
+	switch rotation.OwnerStorage {
+	case OwnerStorageCwOwnable:
+		var ownership cwOwnableOwnership
+		if err = json.Unmarshal(storeVal, &ownership); err != nil {
+			return "", fmt.Errorf("failed to decode cw-ownable ownership: %w", err)
+		}
+		oldOwner = ownership.Owner
+
+		// Pending ownership transfer initiated by the original owner is dropped
+		newVal, err = json.Marshal(cwOwnableOwnership{Owner: newOwner, PendingExpiry: json.RawMessage("null")})
+	default:
+		if err = json.Unmarshal(storeVal, &oldOwner); err != nil {
+			return "", fmt.Errorf("failed to decode cw-controllers admin: %w", err)
+		}
+		newVal, err = json.Marshal(newOwner)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	prefixStore.Set(key, newVal)
+
+	return optionalAddrToString(oldOwner), nil
+}
+
+// RotateContractAdmins applies admin rotations of the network config, both wasmd admin and owner changes are recorded in
+// `AdminUpdated` and as contract operation records
+func (app *App) RotateContractAdmins(ctx types.Context, networkInfo *NetworkConfig, manifest *UpgradeManifest) error {
+	if networkInfo.Contracts == nil || len(networkInfo.Contracts.AdminRotations) == 0 {
+		return nil
+	}
+
+	rotations := networkInfo.Contracts.AdminRotations
+	if err := ValidateContractAdminRotations(rotations); err != nil {
+		return err
+	}
+
+	for i := range rotations {
+		rotation := &rotations[i]
+
+		addr, err := types.AccAddressFromBech32(rotation.Contract)
+		if err != nil {
+			return fmt.Errorf("invalid contract address: %v", err)
+		}
+		contractInfo := app.WasmKeeper.GetContractInfo(ctx, addr)
+		if contractInfo == nil {
+			return fmt.Errorf("contract %s does not exist", rotation.Contract)
+		}
+		if rotation.ExpectedAdmin != nil && contractInfo.Admin != *rotation.ExpectedAdmin {
+			return fmt.Errorf("contract %s admin is \"%s\", expected \"%s\"", rotation.Contract, contractInfo.Admin, *rotation.ExpectedAdmin)
+		}
+
+		if rotation.NewAdmin != nil {
+			err = app.UpgradeContractAdmin(ctx, &rotation.Contract, rotation.NewAdmin, manifest)
+			if err != nil {
+				return err
+			}
+			update := &manifest.Contracts.AdminUpdated[len(manifest.Contracts.AdminUpdated)-1]
+			update.Kind = ContractAdminKindWasmAdmin
+
+			registerContractOperationRecord(manifest, ContractOperationRecord{
+				Index:    i,
+				Source:   ContractOpSourceAdminRotations,
+				Type:     ContractOpSetAdmin,
+				Contract: rotation.Contract,
+				From:     update.From,
+				To:       update.To,
+			})
+		}
+
+		if rotation.NewOwner != nil {
+			oldOwner, err := app.rewriteContractOwner(ctx, rotation)
+			if err != nil {
+				return err
+			}
+
+			if manifest.Contracts == nil {
+				manifest.Contracts = new(Contracts)
+			}
+			manifest.Contracts.AdminUpdated = append(manifest.Contracts.AdminUpdated, ContractValueUpdate{
+				Address: rotation.Contract,
+				From:    oldOwner,
+				To:      *rotation.NewOwner,
+				Kind:    rotation.OwnerStorage,
+			})
+
+			registerContractOperationRecord(manifest, ContractOperationRecord{
+				Index:    i,
+				Source:   ContractOpSourceAdminRotations,
+				Type:     ContractOpSetOwner,
+				Contract: rotation.Contract,
+				Key:      hexOrEmpty([]byte(rotation.ownerItemKey())),
+				From:     oldOwner,
+				To:       *rotation.NewOwner,
+			})
+		}
+	}
+
+	return nil
+}
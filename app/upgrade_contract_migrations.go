@@ -0,0 +1,190 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"os"
+)
+
+// ContractMigration migrates contract to new code by running its migrate entry point. New code is either
+// the wasm blob from `WasmPath`, stored during the upgrade unless identical code is already present, or existing `CodeID`.
+type ContractMigration struct {
+	Contract   string          `json:"contract"`
+	WasmPath   string          `json:"wasm_path,omitempty"`   // Relative path is resolved against directory of the config file, gzipped blob is accepted
+	WasmSha256 string          `json:"wasm_sha256,omitempty"` // Expected sha256 of the wasm blob file, required if the path is set
+	CodeID     uint64          `json:"code_id,omitempty"`
+	MigrateMsg json.RawMessage `json:"migrate_msg"`
+
+	wasmCode []byte
+}
+
+// resolveWasmCode loads and verifies the wasm blob if given
+func (m *ContractMigration) resolveWasmCode(configDir string) error {
+	if m.WasmPath == "" {
+		return nil
+	}
+	if m.WasmSha256 == "" {
+		return fmt.Errorf("expected sha256 of wasm file \"%s\" is not set", m.WasmPath)
+	}
+
+	wasmPath, err := resolveConfigFilePath(m.WasmPath, configDir)
+	if err != nil {
+		return err
+	}
+
+	wasmCode, err := os.ReadFile(wasmPath)
+	if err != nil {
+		return fmt.Errorf("failed to read wasm file: %w", err)
+	}
+
+	if isVerified, actualHashHex, err := VerifySha256(wasmCode, &m.WasmSha256); err != nil {
+		return err
+	} else if !isVerified {
+		return fmt.Errorf("failed to verify sha256: wasm file \"%s\" hash \"%s\" does not match expected hash \"%s\"", wasmPath, actualHashHex, m.WasmSha256)
+	}
+
+	m.wasmCode = wasmCode
+	return nil
+}
+
+func (m *ContractMigration) Validate() error {
+	if err := verifyAddress(m.Contract, nil); err != nil {
+		return fmt.Errorf("contract: %w", err)
+	}
+	if (m.WasmPath == "") == (m.CodeID == 0) {
+		return fmt.Errorf("exactly one of wasm_path and code_id must be set")
+	}
+	if len(m.MigrateMsg) == 0 || !json.Valid(m.MigrateMsg) {
+		return fmt.Errorf("migrate_msg is not valid JSON")
+	}
+	return nil
+}
+
+func ValidateContractMigrations(migrations []ContractMigration) error {
+	for i := range migrations {
+		if err := migrations[i].Validate(); err != nil {
+			return fmt.Errorf("contract migration %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// findCodeID returns ID of already stored code with the given checksum
+func (app *App) findCodeID(ctx types.Context, checksum []byte) (uint64, bool) {
+	var found uint64
+	app.WasmKeeper.IterateCodeInfos(ctx, func(codeID uint64, info wasmTypes.CodeInfo) bool {
+		if bytes.Equal(info.CodeHash, checksum) {
+			found = codeID
+			return true
+		}
+		return false
+	})
+	return found, found != 0
+}
+
+func (app *App) resolveMigrationCodeID(ctx types.Context, contractKeeper *wasmkeeper.PermissionedKeeper, authority types.AccAddress, migration *ContractMigration, record *ContractMigrationRecord) error {
+	if migration.CodeID != 0 {
+		if app.WasmKeeper.GetCodeInfo(ctx, migration.CodeID) == nil {
+			return fmt.Errorf("code id %d does not exist", migration.CodeID)
+		}
+		record.ToCodeID = migration.CodeID
+		return nil
+	}
+
+	if migration.wasmCode == nil {
+		return fmt.Errorf("wasm file \"%s\" is not loaded", migration.WasmPath)
+	}
+
+	wasmCode, err := ioutils.Uncompress(migration.wasmCode, uint64(wasmTypes.MaxWasmSize))
+	if err != nil {
+		return fmt.Errorf("failed to uncompress wasm file: %w", err)
+	}
+	checksum := sha256.Sum256(wasmCode)
+	record.CodeChecksum = hex.EncodeToString(checksum[:])
+
+	// Code hash of stored code is sha256 of uncompressed wasm
+	if codeID, exists := app.findCodeID(ctx, checksum[:]); exists {
+		record.ToCodeID = codeID
+		return nil
+	}
+
+	codeID, err := contractKeeper.Create(ctx, authority, wasmCode, nil)
+	if err != nil {
+		return fmt.Errorf("failed to store wasm code: %w", err)
+	}
+	record.ToCodeID = codeID
+	record.CodeStored = true
+
+	return nil
+}
+
+// MigrateContracts stores new contract code where needed and runs migrate entry point of each contract with governance authority
+func (app *App) MigrateContracts(ctx types.Context, networkInfo *NetworkConfig, manifest *UpgradeManifest) error {
+	if networkInfo.Contracts == nil || len(networkInfo.Contracts.Migrations) == 0 {
+		return nil
+	}
+
+	migrations := networkInfo.Contracts.Migrations
+	if err := ValidateContractMigrations(migrations); err != nil {
+		return err
+	}
+
+	// Governance permission keeper allows to store code and migrate any contract regardless of its admin
+	contractKeeper := wasmkeeper.NewGovPermissionKeeper(app.WasmKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	if manifest.Contracts == nil {
+		manifest.Contracts = new(Contracts)
+	}
+
+	for i := range migrations {
+		migration := &migrations[i]
+
+		contractAddr, err := types.AccAddressFromBech32(migration.Contract)
+		if err != nil {
+			return fmt.Errorf("invalid contract address: %v", err)
+		}
+		contractInfo := app.WasmKeeper.GetContractInfo(ctx, contractAddr)
+		if contractInfo == nil {
+			return fmt.Errorf("contract %s does not exist", migration.Contract)
+		}
+
+		record := ContractMigrationRecord{
+			Contract:   migration.Contract,
+			FromCodeID: contractInfo.CodeID,
+			MigrateMsg: migration.MigrateMsg,
+		}
+
+		err = app.resolveMigrationCodeID(ctx, contractKeeper, authority, migration, &record)
+		if err != nil {
+			return fmt.Errorf("contract migration of %s: %w", migration.Contract, err)
+		}
+
+		record.Response, err = contractKeeper.Migrate(ctx, contractAddr, authority, record.ToCodeID, migration.MigrateMsg)
+		if err != nil {
+			return fmt.Errorf("contract migration of %s to code id %d failed: %w", migration.Contract, record.ToCodeID, err)
+		}
+
+		manifest.Contracts.Migrated = append(manifest.Contracts.Migrated, record)
+
+		registerContractOperationRecord(manifest, ContractOperationRecord{
+			Index:    i,
+			Source:   ContractOpSourceMigrations,
+			Type:     ContractOpMigrate,
+			Contract: migration.Contract,
+			From:     fmt.Sprintf("%d", record.FromCodeID),
+			To:       fmt.Sprintf("%d", record.ToCodeID),
+		})
+	}
+
+	return nil
+}
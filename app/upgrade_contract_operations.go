@@ -0,0 +1,313 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ContractOpSetRaw         = "set_raw"
+	ContractOpDeleteRaw      = "delete_raw"
+	ContractOpSetItem        = "set_item"
+	ContractOpUpsertMapEntry = "upsert_map_entry"
+	ContractOpDeleteMapEntry = "delete_map_entry"
+	ContractOpWipePrefix     = "wipe_prefix"
+	ContractOpSetLabel       = "set_label"
+	ContractOpSetCW2Version  = "set_cw2_version"
+
+	// Types of records of contract changes done by admin rotations and contract migrations, they are not operations
+	ContractOpSetAdmin = "set_admin"
+	ContractOpSetOwner = "set_owner"
+	ContractOpMigrate  = "migrate"
+
+	// Sources of contract operation records, index of the record points to the list of the source
+	ContractOpSourceOperations     = "operations"
+	ContractOpSourceAdminRotations = "admin_rotations"
+	ContractOpSourceMigrations     = "migrations"
+)
+
+// ContractOperation is a single declarative change of contract state or contract info applied during the upgrade.
+// Which fields are used depends on the operation type:
+//   - set_raw, delete_raw: KeyHex, ValueHex
+//   - set_item: Key, Value
+//   - upsert_map_entry, delete_map_entry: Namespace, MapKey or MapKeyHex, Value
+//   - wipe_prefix: KeyHex or Namespace
+//   - set_label: NewLabel, set_cw2_version: NewCW2Version
+//
+// Admins are changed by admin rotations and code is changed by contract migrations, which call migrate entry point of the contract.
+// Their changes are recorded in manifest together with operations as set_admin, set_owner and migrate records.
+type ContractOperation struct {
+	Type     string `json:"type"`
+	Contract string `json:"contract"`
+
+	Key       string          `json:"key,omitempty"`         // Item key, stored as is
+	KeyHex    string          `json:"key_hex,omitempty"`     // Raw key, or raw prefix for wipe_prefix
+	Namespace string          `json:"namespace,omitempty"`   // Map namespace, encoded as length-prefixed string
+	MapKey    string          `json:"map_key,omitempty"`     // Map key as string
+	MapKeyHex string          `json:"map_key_hex,omitempty"` // Map key as raw bytes, e.g. canonical address
+	Value     json.RawMessage `json:"value,omitempty"`       // JSON value of Item or Map entry
+	ValueHex  string          `json:"value_hex,omitempty"`   // Raw value
+
+	NewLabel      *string             `json:"new_label,omitempty"`
+	NewCW2Version *CW2ContractVersion `json:"new_cw2_version,omitempty"`
+}
+
+func decodeHexField(name string, val string) ([]byte, error) {
+	if val == "" {
+		return nil, fmt.Errorf("%s is not set", name)
+	}
+	res, err := hex.DecodeString(DropHexPrefix(val))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid hex: %w", name, err)
+	}
+	return res, nil
+}
+
+func compactJSONValue(value json.RawMessage) ([]byte, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("value is not set")
+	}
+	var val interface{}
+	if err := json.Unmarshal(value, &val); err != nil {
+		return nil, fmt.Errorf("value is not valid JSON: %w", err)
+	}
+	return json.Marshal(val)
+}
+
+func (op *ContractOperation) mapEntryKey() ([]byte, error) {
+	if op.Namespace == "" {
+		return nil, fmt.Errorf("namespace is not set")
+	}
+
+	var mapKey []byte
+	switch {
+	case op.MapKey != "" && op.MapKeyHex != "":
+		return nil, fmt.Errorf("only one of map_key and map_key_hex can be set")
+	case op.MapKey != "":
+		mapKey = []byte(op.MapKey)
+	default:
+		var err error
+		if mapKey, err = decodeHexField("map_key_hex", op.MapKeyHex); err != nil {
+			return nil, err
+		}
+	}
+
+	return append(prefixStringWithLength(op.Namespace), mapKey...), nil
+}
+
+// storeKey returns raw key in contract store the operation works with
+func (op *ContractOperation) storeKey() ([]byte, error) {
+	switch op.Type {
+	case ContractOpSetRaw, ContractOpDeleteRaw:
+		return decodeHexField("key_hex", op.KeyHex)
+	case ContractOpSetItem:
+		if op.Key == "" {
+			return nil, fmt.Errorf("key is not set")
+		}
+		return []byte(op.Key), nil
+	case ContractOpUpsertMapEntry, ContractOpDeleteMapEntry:
+		return op.mapEntryKey()
+	case ContractOpWipePrefix:
+		if op.Namespace != "" {
+			if op.KeyHex != "" {
+				return nil, fmt.Errorf("only one of key_hex and namespace can be set")
+			}
+			return prefixStringWithLength(op.Namespace), nil
+		}
+		return decodeHexField("key_hex", op.KeyHex)
+	}
+	return nil, nil
+}
+
+// storeValue returns raw value the operation writes to contract store
+func (op *ContractOperation) storeValue() ([]byte, error) {
+	switch op.Type {
+	case ContractOpSetRaw:
+		return decodeHexField("value_hex", op.ValueHex)
+	case ContractOpSetItem, ContractOpUpsertMapEntry:
+		return compactJSONValue(op.Value)
+	}
+	return nil, nil
+}
+
+// Validate checks that all fields required by the operation type are set and well-formed
+func (op *ContractOperation) Validate() error {
+	if err := verifyAddress(op.Contract, nil); err != nil {
+		return fmt.Errorf("contract: %w", err)
+	}
+
+	switch op.Type {
+	case ContractOpSetRaw, ContractOpDeleteRaw, ContractOpSetItem, ContractOpUpsertMapEntry, ContractOpDeleteMapEntry, ContractOpWipePrefix:
+		if _, err := op.storeKey(); err != nil {
+			return err
+		}
+		if _, err := op.storeValue(); err != nil {
+			return err
+		}
+	case ContractOpSetLabel:
+		if op.NewLabel == nil || *op.NewLabel == "" {
+			return fmt.Errorf("new_label is not set")
+		}
+	case ContractOpSetCW2Version:
+		// Unset version removes the CW2 record
+	default:
+		return fmt.Errorf("unknown operation type \"%s\"", op.Type)
+	}
+
+	return nil
+}
+
+func ValidateContractOperations(operations []ContractOperation) error {
+	for i := range operations {
+		if err := operations[i].Validate(); err != nil {
+			return fmt.Errorf("contract operation %d (%s): %w", i, operations[i].Type, err)
+		}
+	}
+	return nil
+}
+
+func hexOrEmpty(val []byte) string {
+	if val == nil {
+		return ""
+	}
+	return hex.EncodeToString(val)
+}
+
+func (app *App) storeContractInfo(ctx types.Context, addr types.AccAddress, contractInfo *wasmTypes.ContractInfo) error {
+	contractBz, err := app.AppCodec().Marshal(contractInfo)
+	if err != nil {
+		return fmt.Errorf("failed to marshal updated contract info: %v", err)
+	}
+
+	store := ctx.KVStore(app.keys[wasmTypes.StoreKey])
+	store.Set(wasmTypes.GetContractAddressKey(addr), contractBz)
+	return nil
+}
+
+func (app *App) applyContractLabelOperation(ctx types.Context, op *ContractOperation, record *ContractOperationRecord) error {
+	addr, err := types.AccAddressFromBech32(op.Contract)
+	if err != nil {
+		return fmt.Errorf("invalid contract address: %v", err)
+	}
+	contractInfo := app.WasmKeeper.GetContractInfo(ctx, addr)
+	if contractInfo == nil {
+		return fmt.Errorf("contract %s does not exist", op.Contract)
+	}
+
+	record.From, record.To = contractInfo.Label, *op.NewLabel
+	contractInfo.Label = *op.NewLabel
+	return app.storeContractInfo(ctx, addr, contractInfo)
+}
+
+// registerContractOperationRecord records applied contract change, so all changes of contracts are listed in the same
+// format and order they were applied in
+func registerContractOperationRecord(manifest *UpgradeManifest, record ContractOperationRecord) {
+	if manifest.Contracts == nil {
+		manifest.Contracts = new(Contracts)
+	}
+	manifest.Contracts.Operations = append(manifest.Contracts.Operations, record)
+}
+
+func (app *App) applyContractOperation(ctx types.Context, op *ContractOperation, record *ContractOperationRecord) error {
+	if op.Type == ContractOpSetLabel {
+		return app.applyContractLabelOperation(ctx, op, record)
+	}
+
+	addr, _, prefixStore, err := app.getContractData(ctx, op.Contract)
+	if err != nil {
+		return err
+	}
+	if !app.WasmKeeper.HasContractInfo(ctx, *addr) {
+		return fmt.Errorf("contract %s does not exist", op.Contract)
+	}
+
+	if op.Type == ContractOpSetCW2Version {
+		key := cw2contractInfoKey
+		record.Key = hexOrEmpty(key)
+		record.From = string(prefixStore.Get(key))
+		if op.NewCW2Version == nil {
+			prefixStore.Delete(key)
+			return nil
+		}
+		value, err := json.Marshal(op.NewCW2Version)
+		if err != nil {
+			return err
+		}
+		record.To = string(value)
+		prefixStore.Set(key, value)
+		return nil
+	}
+
+	key, err := op.storeKey()
+	if err != nil {
+		return err
+	}
+	value, err := op.storeValue()
+	if err != nil {
+		return err
+	}
+	record.Key = hexOrEmpty(key)
+
+	switch op.Type {
+	case ContractOpSetRaw:
+		record.From, record.To = hexOrEmpty(prefixStore.Get(key)), hexOrEmpty(value)
+		prefixStore.Set(key, value)
+	case ContractOpDeleteRaw:
+		record.From = hexOrEmpty(prefixStore.Get(key))
+		prefixStore.Delete(key)
+	case ContractOpSetItem, ContractOpUpsertMapEntry:
+		record.From, record.To = string(prefixStore.Get(key)), string(value)
+		prefixStore.Set(key, value)
+	case ContractOpDeleteMapEntry:
+		record.From = string(prefixStore.Get(key))
+		prefixStore.Delete(key)
+	case ContractOpWipePrefix:
+		iter := types.KVStorePrefixIterator(prefixStore, key)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		if err := iter.Close(); err != nil {
+			return err
+		}
+		for _, k := range keys {
+			prefixStore.Delete(k)
+		}
+		record.NumberOfDeletedKeys = len(keys)
+	}
+
+	return nil
+}
+
+// ApplyContractOperations applies declarative contract operations of the network config in the given order
+func (app *App) ApplyContractOperations(ctx types.Context, networkInfo *NetworkConfig, manifest *UpgradeManifest) error {
+	if networkInfo.Contracts == nil || len(networkInfo.Contracts.Operations) == 0 {
+		return nil
+	}
+
+	operations := networkInfo.Contracts.Operations
+	if err := ValidateContractOperations(operations); err != nil {
+		return err
+	}
+
+	for i := range operations {
+		op := operations[i]
+		record := ContractOperationRecord{
+			Index:    i,
+			Source:   ContractOpSourceOperations,
+			Type:     op.Type,
+			Contract: op.Contract,
+		}
+
+		if err := app.applyContractOperation(ctx, &op, &record); err != nil {
+			return fmt.Errorf("contract operation %d (%s) on %s: %w", i, op.Type, op.Contract, err)
+		}
+
+		registerContractOperationRecord(manifest, record)
+	}
+
+	return nil
+}
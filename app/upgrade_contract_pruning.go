@@ -0,0 +1,289 @@
+package app
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types"
+	"strconv"
+	"strings"
+)
+
+const (
+	PredicateValueBlockHeight      = "$block_height"
+	PredicateValueBlockTimeSeconds = "$block_time_seconds"
+	PredicateValueBlockTimeNanos   = "$block_time_nanos"
+)
+
+// ContractStatePredicate selects JSON values by comparing the field at dot-separated path, e.g. `expiry.at_height`.
+// Numbers and numeric strings are compared numerically, other values only by equality.
+// Value can refer to the upgrade block using one of the `$block_*` placeholders.
+type ContractStatePredicate struct {
+	Field string `json:"field"`
+	Op    string `json:"op"` // One of lt, lte, gt, gte, eq, ne
+	Value string `json:"value"`
+}
+
+// ContractStatePruning removes only matching records instead of the whole contract state
+type ContractStatePruning struct {
+	Namespaces []string                `json:"namespaces,omitempty"` // Item keys or Map namespaces, empty means whole store
+	Predicate  *ContractStatePredicate `json:"predicate,omitempty"`  // Records without predicate are removed unconditionally
+}
+
+func isPredicateValuePlaceholder(value string) bool {
+	switch value {
+	case PredicateValueBlockHeight, PredicateValueBlockTimeSeconds, PredicateValueBlockTimeNanos:
+		return true
+	}
+	return false
+}
+
+func (p *ContractStatePredicate) Validate() error {
+	if p.Field == "" {
+		return fmt.Errorf("predicate field is not set")
+	}
+	for _, field := range strings.Split(p.Field, ".") {
+		if field == "" {
+			return fmt.Errorf("predicate field \"%s\" has empty path segment", p.Field)
+		}
+	}
+
+	if strings.HasPrefix(p.Value, "$") && !isPredicateValuePlaceholder(p.Value) {
+		return fmt.Errorf("unknown predicate value placeholder \"%s\"", p.Value)
+	}
+
+	switch p.Op {
+	case "eq", "ne":
+	case "lt", "lte", "gt", "gte":
+		// Ordering of non-numeric values is never matched, so such predicate would silently keep all records
+		if !isPredicateValuePlaceholder(p.Value) {
+			if _, err := types.NewDecFromStr(p.Value); err != nil {
+				return fmt.Errorf("predicate op \"%s\" requires numeric value, got \"%s\"", p.Op, p.Value)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown predicate op \"%s\"", p.Op)
+	}
+	return nil
+}
+
+func (p *ContractStatePruning) Validate() error {
+	if p == nil {
+		return nil
+	}
+	for _, namespace := range p.Namespaces {
+		if namespace == "" {
+			return fmt.Errorf("pruning namespace is empty")
+		}
+	}
+	if p.Predicate == nil {
+		return nil
+	}
+	return p.Predicate.Validate()
+}
+
+// ValidateContractStatePruning validates pruning of all contracts of the contract set, so that invalid pruning config
+// is refused before any contract state is changed
+func ValidateContractStatePruning(contracts *ContractSet) error {
+	if contracts == nil {
+		return nil
+	}
+
+	for _, contract := range []*ProdDevContract{contracts.Almanac, contracts.AName} {
+		if contract == nil {
+			continue
+		}
+		if err := contract.Prune.Validate(); err != nil {
+			return fmt.Errorf("contracts %v pruning: %w", contract.GetContracts(nil), err)
+		}
+	}
+
+	return nil
+}
+
+func resolvePredicateValue(ctx types.Context, value string) string {
+	switch value {
+	case PredicateValueBlockHeight:
+		return strconv.FormatInt(ctx.BlockHeight(), 10)
+	case PredicateValueBlockTimeSeconds:
+		return strconv.FormatInt(ctx.BlockTime().Unix(), 10)
+	case PredicateValueBlockTimeNanos:
+		return strconv.FormatInt(ctx.BlockTime().UnixNano(), 10)
+	}
+	return value
+}
+
+// lookupJSONField returns string representation of the scalar field, false if value is not JSON or field is missing
+func lookupJSONField(value []byte, path string) (string, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+
+	var current interface{}
+	if err := decoder.Decode(&current); err != nil {
+		return "", false
+	}
+
+	for _, field := range strings.Split(path, ".") {
+		obj, isObject := current.(map[string]interface{})
+		if !isObject {
+			return "", false
+		}
+		if current, isObject = obj[field]; !isObject {
+			return "", false
+		}
+	}
+
+	switch val := current.(type) {
+	case string:
+		return val, true
+	case json.Number:
+		return val.String(), true
+	case bool:
+		return strconv.FormatBool(val), true
+	}
+	return "", false
+}
+
+func (p *ContractStatePredicate) matches(ctx types.Context, value []byte) bool {
+	fieldValue, found := lookupJSONField(value, p.Field)
+	if !found {
+		return false
+	}
+	expected := resolvePredicateValue(ctx, p.Value)
+
+	fieldNum, fieldErr := types.NewDecFromStr(fieldValue)
+	expectedNum, expectedErr := types.NewDecFromStr(expected)
+	if fieldErr != nil || expectedErr != nil {
+		switch p.Op {
+		case "eq":
+			return fieldValue == expected
+		case "ne":
+			return fieldValue != expected
+		}
+		return false
+	}
+
+	switch p.Op {
+	case "lt":
+		return fieldNum.LT(expectedNum)
+	case "lte":
+		return fieldNum.LTE(expectedNum)
+	case "gt":
+		return fieldNum.GT(expectedNum)
+	case "gte":
+		return fieldNum.GTE(expectedNum)
+	case "eq":
+		return fieldNum.Equal(expectedNum)
+	default:
+		return !fieldNum.Equal(expectedNum)
+	}
+}
+
+// namespaceKeyRange returns iteration range of all entries of Map namespace, nil range means whole store
+func namespaceKeyRange(namespace string) (start []byte, end []byte) {
+	if namespace == "" {
+		return nil, nil
+	}
+	start = prefixStringWithLength(namespace)
+	return start, types.PrefixEndBytes(start)
+}
+
+func pruneContractNamespace(ctx types.Context, prefixStore *prefix.Store, namespace string, predicate *ContractStatePredicate) ContractStatePrunedRange {
+	res := ContractStatePrunedRange{Namespace: namespace}
+
+	var keysToDelete [][]byte
+	collect := func(key []byte, value []byte) {
+		if predicate != nil && !predicate.matches(ctx, value) {
+			res.NumberOfKeptKeys++
+			return
+		}
+		keysToDelete = append(keysToDelete, append([]byte{}, key...))
+	}
+
+	// Item is stored directly under its key
+	if namespace != "" {
+		if value := prefixStore.Get([]byte(namespace)); value != nil {
+			collect([]byte(namespace), value)
+		}
+	}
+
+	start, end := namespaceKeyRange(namespace)
+	iter := prefixStore.Iterator(start, end)
+	for ; iter.Valid(); iter.Next() {
+		collect(iter.Key(), iter.Value())
+	}
+	iter.Close()
+
+	for _, key := range keysToDelete {
+		prefixStore.Delete(key)
+	}
+
+	res.NumberOfDeletedKeys = len(keysToDelete)
+	if len(keysToDelete) > 0 {
+		res.FirstDeletedKey = hex.EncodeToString(keysToDelete[0])
+		res.LastDeletedKey = hex.EncodeToString(keysToDelete[len(keysToDelete)-1])
+	}
+
+	return res
+}
+
+// PruneContractState removes records selected by the pruning config and reports removed keys per namespace
+func (app *App) PruneContractState(ctx types.Context, contractAddr string, pruning *ContractStatePruning, manifest *UpgradeManifest) error {
+	if contractAddr == "" {
+		return nil
+	}
+	if err := pruning.Validate(); err != nil {
+		return fmt.Errorf("contract %s pruning: %w", contractAddr, err)
+	}
+
+	_, _, prefixStore, err := app.getContractData(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+
+	namespaces := []string{""}
+	var predicate *ContractStatePredicate
+	if pruning != nil {
+		if len(pruning.Namespaces) > 0 {
+			namespaces = pruning.Namespaces
+		}
+		predicate = pruning.Predicate
+	}
+
+	record := ContractStatePruningRecord{
+		Contract:  contractAddr,
+		Predicate: predicate,
+	}
+	for _, namespace := range namespaces {
+		prunedRange := pruneContractNamespace(ctx, prefixStore, namespace, predicate)
+		record.Namespaces = append(record.Namespaces, prunedRange)
+		record.NumberOfDeletedKeys += prunedRange.NumberOfDeletedKeys
+	}
+
+	if manifest.Contracts == nil {
+		manifest.Contracts = new(Contracts)
+	}
+	manifest.Contracts.StatePruned = append(manifest.Contracts.StatePruned, record)
+	if pruning == nil {
+		manifest.Contracts.StateCleaned = append(manifest.Contracts.StateCleaned, contractAddr)
+	}
+
+	return nil
+}
+
+func (app *App) PruneProdDevContractStates(ctx types.Context, contract *ProdDevContract, manifest *UpgradeManifest) error {
+	if contract == nil {
+		return nil
+	}
+
+	for _, contractAddr := range contract.GetContracts(nil) {
+		err := app.PruneContractState(ctx, contractAddr, contract.Prune, manifest)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -145,6 +146,7 @@ type GenesisData struct {
 	NotBondedPoolAddress string
 
 	DistributionInfo *DistributionInfo
+	GovInfo          *GovInfo
 
 	GravityModuleAccountAddress string
 
@@ -185,6 +187,8 @@ func LoadCudosGenesis(app *App, manifest *UpgradeManifest) (*map[string]interfac
 }
 
 func ProcessSourceNetworkGenesis(logger log.Logger, cudosCfg *CudosMergeConfig, genesisData *GenesisData, manifest *UpgradeManifest) error {
+	writeSourceSupplyBreakdownToManifest(genesisData, cudosCfg, manifest)
+
 	err := writeInitialBalancesToManifest(genesisData, manifest)
 	if err != nil {
 		return fmt.Errorf("cudos merge: failed to write initial balances to manifest: %w", err)
@@ -215,6 +219,11 @@ func ProcessSourceNetworkGenesis(logger log.Logger, cudosCfg *CudosMergeConfig,
 		return fmt.Errorf("cudos merge: failed to withdraw gravity: %w", err)
 	}
 
+	err = withdrawGenesisGovDeposits(genesisData, cudosCfg, manifest)
+	if err != nil {
+		return fmt.Errorf("cudos merge: failed to refund gov deposits: %w", err)
+	}
+
 	err = withdrawGenesisRemainingModulesBalance(genesisData, cudosCfg, manifest)
 	if err != nil {
 		return fmt.Errorf("cudos merge: failed to withdraw remaining modules balance: %w", err)
@@ -361,11 +370,18 @@ func CudosMergeUpgradeHandler(app *App, ctx sdk.Context, cudosCfg *CudosMergeCon
 		return fmt.Errorf("cudos merge: cudos path not set")
 	}
 
+	destinationSupplyBefore := sdk.NewCoins(app.BankKeeper.GetSupply(ctx, app.StakingKeeper.BondDenom(ctx)))
+
 	err := ProcessSourceNetworkGenesis(app.Logger(), cudosCfg, genesisData, manifest)
 	if err != nil {
 		return err
 	}
 
+	err = WriteExpectedDestinationSupplyToManifest(genesisData, cudosCfg, app.StakingKeeper.BondDenom(ctx), manifest)
+	if err != nil {
+		return fmt.Errorf("cudos merge: failed to calculate expected destination supply: %w", err)
+	}
+
 	err = MigrateGenesisAccounts(genesisData, ctx, app, cudosCfg, manifest)
 	if err != nil {
 		return fmt.Errorf("cudos merge: failed process accounts: %w", err)
@@ -383,7 +399,7 @@ func CudosMergeUpgradeHandler(app *App, ctx sdk.Context, cudosCfg *CudosMergeCon
 		return fmt.Errorf("cudos merge: failed process delegations: %w", err)
 	}
 
-	err = verifySupply(app, ctx, cudosCfg, manifest)
+	err = verifySupply(app, ctx, cudosCfg, destinationSupplyBefore, manifest)
 	if err != nil {
 		return fmt.Errorf("cudos merge: failed to verify supply: %w", err)
 	}
@@ -537,6 +553,11 @@ func ParseGenesisData(jsonData map[string]interface{}, genDoc *tmtypes.GenesisDo
 	}
 	genesisData.DistributionInfo = distributionInfo
 
+	genesisData.GovInfo, err = parseGenesisGov(jsonData, genesisData.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gov module data: %w", err)
+	}
+
 	gravityModuleAccountAddress, err := GetAddressByName(genesisData.Accounts, GravityAccName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get gravity module account: %w", err)
@@ -553,9 +574,12 @@ func ParseGenesisData(jsonData map[string]interface{}, genDoc *tmtypes.GenesisDo
 
 type AccountInfo struct {
 	// Base
-	Pubkey     cryptotypes.PubKey
-	Address    string
-	RawAddress sdk.AccAddress
+	Pubkey        cryptotypes.PubKey
+	PubkeyType    string // Type of the source pubkey, set also if the pubkey was dropped
+	PubkeyIssue   string // Reason why the source pubkey was dropped
+	Address       string
+	RawAddress    sdk.AccAddress
+	AccountNumber *uint64 // Source account number, nil for accounts created during the processing
 
 	// Bank
 	Balance sdk.Coins
@@ -605,18 +629,8 @@ func parseGenesisBaseVesting(baseVestingAccData map[string]interface{}, accountI
 func parseGenesisBaseAccount(baseAccData map[string]interface{}, accountInfo *AccountInfo) error {
 	accountInfo.Address = baseAccData["address"].(string)
 
-	// Parse Pubkey
-	var AccPubKey cryptotypes.PubKey
-	var err error
-	if pk, ok := baseAccData["pub_key"]; ok {
-		if pk != nil {
-			AccPubKey, err = decodePubKeyFromMap(pk.(map[string]interface{}))
-			if err != nil {
-				return err
-			}
-		}
-	}
-	accountInfo.Pubkey = AccPubKey
+	accountNumber := cast.ToUint64(baseAccData["account_number"])
+	accountInfo.AccountNumber = &accountNumber
 
 	// Get raw address
 	_, accRawAddr, err := bech32.DecodeAndConvert(accountInfo.Address)
@@ -626,6 +640,13 @@ func parseGenesisBaseAccount(baseAccData map[string]interface{}, accountInfo *Ac
 		return err
 	}
 
+	// Parse Pubkey
+	if pk, ok := baseAccData["pub_key"]; ok {
+		if pk != nil {
+			accountInfo.Pubkey, accountInfo.PubkeyType, accountInfo.PubkeyIssue = resolveAccountPubKey(pk.(map[string]interface{}), accRawAddr)
+		}
+	}
+
 	return nil
 }
 
@@ -767,6 +788,7 @@ func parseGenesisAccounts(jsonData map[string]interface{}, contractAccountMap *O
 		}
 
 		accountMap.SetNew(accountInfo.Address, accountInfo)
+		registerAccountPubKey(manifest, accountInfo)
 	}
 
 	// Add balances to accounts map
@@ -789,7 +811,7 @@ func parseGenesisDelegations(validators *OrderedMap[string, *ValidatorInfo], con
 		for j := range validator.Delegations.Iterate() {
 			delegatorAddress, delegation := j.Key, j.Value
 
-			resolvedDelegatorAddress, err := resolveIfContractAddressWithFallback(delegatorAddress, contracts, cudosCfg)
+			recipients, _, err := resolveContractRecipients(delegatorAddress, contracts, cudosCfg)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -802,21 +824,29 @@ func parseGenesisDelegations(validators *OrderedMap[string, *ValidatorInfo], con
 				continue
 			}
 
-			// Subtract balance from bonded or not-bonded pool
-			if currentValidatorInfo.Status == BondedStatus {
+			// Delegation of split contract is divided between its beneficiaries
+			for k, recipientTokens := range splitByWeight(delegatorTokens, recipients) {
+				if recipientTokens.IsZero() {
+					continue
+				}
+				resolvedDelegatorAddress := recipients[k].Key
 
-				// Store delegation to delegated map
-				resolvedDelegatorMap, _ := delegatedBalanceMap.GetOrSetDefault(resolvedDelegatorAddress, NewOrderedMap[string, sdk.Int]())
-				resolvedDelegator, _ := resolvedDelegatorMap.GetOrSetDefault(validatorOperatorAddress, sdk.NewInt(0))
-				resolvedDelegatorMap.Set(validatorOperatorAddress, resolvedDelegator.Add(delegatorTokens))
-				delegatedBalanceMap.Set(resolvedDelegatorAddress, resolvedDelegatorMap)
-			} else {
+				// Subtract balance from bonded or not-bonded pool
+				if currentValidatorInfo.Status == BondedStatus {
 
-				// Store delegation to delegated map
-				resolvedDelegatorMap, _ := unbondingDelegatedBalanceMap.GetOrSetDefault(resolvedDelegatorAddress, NewOrderedMap[string, sdk.Int]())
-				resolvedDelegator, _ := resolvedDelegatorMap.GetOrSetDefault(validatorOperatorAddress, sdk.NewInt(0))
-				resolvedDelegatorMap.Set(validatorOperatorAddress, resolvedDelegator.Add(delegatorTokens))
-				unbondingDelegatedBalanceMap.Set(resolvedDelegatorAddress, resolvedDelegatorMap)
+					// Store delegation to delegated map
+					resolvedDelegatorMap, _ := delegatedBalanceMap.GetOrSetDefault(resolvedDelegatorAddress, NewOrderedMap[string, sdk.Int]())
+					resolvedDelegator, _ := resolvedDelegatorMap.GetOrSetDefault(validatorOperatorAddress, sdk.NewInt(0))
+					resolvedDelegatorMap.Set(validatorOperatorAddress, resolvedDelegator.Add(recipientTokens))
+					delegatedBalanceMap.Set(resolvedDelegatorAddress, resolvedDelegatorMap)
+				} else {
+
+					// Store delegation to delegated map
+					resolvedDelegatorMap, _ := unbondingDelegatedBalanceMap.GetOrSetDefault(resolvedDelegatorAddress, NewOrderedMap[string, sdk.Int]())
+					resolvedDelegator, _ := resolvedDelegatorMap.GetOrSetDefault(validatorOperatorAddress, sdk.NewInt(0))
+					resolvedDelegatorMap.Set(validatorOperatorAddress, resolvedDelegator.Add(recipientTokens))
+					unbondingDelegatedBalanceMap.Set(resolvedDelegatorAddress, resolvedDelegatorMap)
+				}
 			}
 		}
 	}
@@ -834,27 +864,30 @@ func parseGenesisUnbondingDelegations(validators *OrderedMap[string, *ValidatorI
 		for j := range validator.UnbondingDelegations.Iterate() {
 			delegatorAddress, delegation := j.Key, j.Value
 
-			resolvedDelegatorAddress, err := resolveIfContractAddressWithFallback(delegatorAddress, contracts, cudosCfg)
+			recipients, _, err := resolveContractRecipients(delegatorAddress, contracts, cudosCfg)
 			if err != nil {
 				return nil, err
 			}
 
-			delegatorTokens := sdk.NewInt(0)
-
 			for _, entry := range delegation.Entries {
-				delegatorTokens = delegatorTokens.Add(entry.Balance)
-			}
+				if entry.Balance.IsZero() {
+					continue
+				}
 
-			if delegatorTokens.IsZero() {
-				// This happens when number of shares is less than 1
-				continue
-			}
+				// Entries are split separately, the same way as they are withdrawn
+				for k, recipientTokens := range splitByWeight(entry.Balance, recipients) {
+					if recipientTokens.IsZero() {
+						continue
+					}
+					resolvedDelegatorAddress := recipients[k].Key
 
-			// Store delegation to delegated map
-			resolvedDelegatorMap, _ := unbondingDelegatedBalanceMap.GetOrSetDefault(resolvedDelegatorAddress, NewOrderedMap[string, sdk.Int]())
-			resolvedDelegator, _ := resolvedDelegatorMap.GetOrSetDefault(validatorOperatorAddress, sdk.NewInt(0))
-			resolvedDelegatorMap.Set(validatorOperatorAddress, resolvedDelegator.Add(delegatorTokens))
-			unbondingDelegatedBalanceMap.Set(resolvedDelegatorAddress, resolvedDelegatorMap)
+					// Store delegation to delegated map
+					resolvedDelegatorMap, _ := unbondingDelegatedBalanceMap.GetOrSetDefault(resolvedDelegatorAddress, NewOrderedMap[string, sdk.Int]())
+					resolvedDelegator, _ := resolvedDelegatorMap.GetOrSetDefault(validatorOperatorAddress, sdk.NewInt(0))
+					resolvedDelegatorMap.Set(validatorOperatorAddress, resolvedDelegator.Add(recipientTokens))
+					unbondingDelegatedBalanceMap.Set(resolvedDelegatorAddress, resolvedDelegatorMap)
+				}
+			}
 		}
 	}
 
@@ -882,6 +915,8 @@ type ValidatorInfo struct {
 	Stake                sdk.Int
 	Shares               sdk.Dec
 	Status               string
+	Jailed               bool
+	SigningInfo          *ValidatorSigningInfo
 	OperatorAddress      string
 	ConsensusPubkey      cryptotypes.PubKey
 	Delegations          *OrderedMap[string, *DelegationInfo]
@@ -918,6 +953,7 @@ func parseGenesisValidators(jsonData map[string]interface{}) (*OrderedMap[string
 		}
 
 		status := validatorMap["status"].(string)
+		jailed := cast.ToBool(validatorMap["jailed"])
 
 		validatorShares := validatorMap["delegator_shares"].(string)
 		validatorSharesDec, err := sdk.NewDecFromStr(validatorShares)
@@ -929,6 +965,7 @@ func parseGenesisValidators(jsonData map[string]interface{}) (*OrderedMap[string
 			Stake:                tokensInt,
 			Shares:               validatorSharesDec,
 			Status:               status,
+			Jailed:               jailed,
 			OperatorAddress:      operatorAddress,
 			ConsensusPubkey:      decodedConsensusPubkey,
 			Delegations:          NewOrderedMap[string, *DelegationInfo](),
@@ -986,6 +1023,11 @@ func parseGenesisValidators(jsonData map[string]interface{}) (*OrderedMap[string
 		validator.UnbondingDelegations.SetNew(delegatorAddress, &UnbondingDelegationInfo{DelegatorAddress: delegatorAddress, Entries: unbondingDelegationEntries})
 	}
 
+	err := parseGenesisSigningInfos(jsonData, validatorInfoMap)
+	if err != nil {
+		return nil, err
+	}
+
 	return validatorInfoMap, nil
 }
 
@@ -997,7 +1039,7 @@ func withdrawGenesisStakingDelegations(logger log.Logger, genesisData *GenesisDa
 		for j := range validator.Delegations.Iterate() {
 			delegatorAddress, delegation := j.Key, j.Value
 
-			resolvedDelegatorAddress, err := resolveIfContractAddressWithFallback(delegatorAddress, genesisData.Contracts, cudosCfg)
+			recipients, routeDecision, err := resolveContractRecipients(delegatorAddress, genesisData.Contracts, cudosCfg)
 			if err != nil {
 				return err
 			}
@@ -1013,10 +1055,11 @@ func withdrawGenesisStakingDelegations(logger log.Logger, genesisData *GenesisDa
 				continue
 			}
 
+			var movedParts []sdk.Coins
 			// Subtract balance from bonded or not-bonded pool
 			if currentValidatorInfo.Status == BondedStatus {
 				// Move balance from bonded pool to delegator
-				err := moveGenesisBalance(genesisData, genesisData.BondedPoolAddress, resolvedDelegatorAddress, delegatorBalance, "bonded_delegation", manifest, cudosCfg)
+				movedParts, err = moveGenesisBalanceToRecipients(genesisData, genesisData.BondedPoolAddress, recipients, delegatorBalance, "bonded_delegation", manifest, cudosCfg)
 				if err != nil {
 					return err
 				}
@@ -1025,19 +1068,22 @@ func withdrawGenesisStakingDelegations(logger log.Logger, genesisData *GenesisDa
 				// Delegations to unbonded/jailed/tombstoned validators are not re-delegated
 
 				// Move balance from not-bonded pool to delegator
-				err := moveGenesisBalance(genesisData, genesisData.NotBondedPoolAddress, resolvedDelegatorAddress, delegatorBalance, "not_bonded_delegation", manifest, cudosCfg)
+				movedParts, err = moveGenesisBalanceToRecipients(genesisData, genesisData.NotBondedPoolAddress, recipients, delegatorBalance, "not_bonded_delegation", manifest, cudosCfg)
 				if err != nil {
 					return err
 				}
 			}
 
+			if routeDecision != "" {
+				registerContractRouting(manifest, delegatorAddress, routeDecision, recipients, movedParts, contractRoutedDelegation)
+			}
 		}
 
 		// Handle unbonding delegations
 		for j := range validator.UnbondingDelegations.Iterate() {
 			delegatorAddress, unbondingDelegation := j.Key, j.Value
 
-			resolvedDelegatorAddress, err := resolveIfContractAddressWithFallback(delegatorAddress, genesisData.Contracts, cudosCfg)
+			recipients, routeDecision, err := resolveContractRecipients(delegatorAddress, genesisData.Contracts, cudosCfg)
 			if err != nil {
 				return err
 			}
@@ -1046,11 +1092,14 @@ func withdrawGenesisStakingDelegations(logger log.Logger, genesisData *GenesisDa
 				unbondingDelegationBalance := sdk.NewCoins(sdk.NewCoin(genesisData.BondDenom, entry.Balance))
 
 				// Move unbonding balance from not-bonded pool to delegator address
-				err := moveGenesisBalance(genesisData, genesisData.NotBondedPoolAddress, resolvedDelegatorAddress, unbondingDelegationBalance, "unbonding_delegation", manifest, cudosCfg)
+				movedParts, err := moveGenesisBalanceToRecipients(genesisData, genesisData.NotBondedPoolAddress, recipients, unbondingDelegationBalance, "unbonding_delegation", manifest, cudosCfg)
 				if err != nil {
 					return err
 				}
 
+				if routeDecision != "" {
+					registerContractRouting(manifest, delegatorAddress, routeDecision, recipients, movedParts, contractRoutedDelegation)
+				}
 			}
 		}
 	}
@@ -1066,6 +1115,7 @@ func withdrawGenesisStakingDelegations(logger log.Logger, genesisData *GenesisDa
 	)
 
 	err := checkTolerance(bondedPool.Balance, maxToleratedRemainingStakingBalance)
+	registerToleranceCheck(manifest, "remaining_bonded_pool_balance", bondedPool.Balance, maxToleratedRemainingStakingBalance, err)
 	if err != nil {
 		return fmt.Errorf("remaining bonded pool balance %s is too high", bondedPool.Balance.String())
 	}
@@ -1083,6 +1133,7 @@ func withdrawGenesisStakingDelegations(logger log.Logger, genesisData *GenesisDa
 	notBondedPool := genesisData.Accounts.MustGet(genesisData.NotBondedPoolAddress)
 
 	err = checkTolerance(notBondedPool.Balance, maxToleratedRemainingStakingBalance)
+	registerToleranceCheck(manifest, "remaining_not_bonded_pool_balance", notBondedPool.Balance, maxToleratedRemainingStakingBalance, err)
 	if err != nil {
 		return fmt.Errorf("remaining not-bonded pool balance %s is too high", notBondedPool.Balance.String())
 	}
@@ -1117,6 +1168,10 @@ func resolveDestinationValidator(ctx sdk.Context, app *App, operatorAddress stri
 		}
 	}
 
+	return resolveBackupValidator(ctx, app, cudosCfg)
+}
+
+func resolveBackupValidator(ctx sdk.Context, app *App, cudosCfg *CudosMergeConfig) (*stakingtypes.Validator, error) {
 	for _, targetOperatorStringAddress := range cudosCfg.Config.BackupValidators {
 		targetOperatorAddress, err := sdk.ValAddressFromBech32(targetOperatorStringAddress)
 		if err != nil {
@@ -1142,6 +1197,64 @@ func getIntAmountFromCoins(balance sdk.Coins, expectedDenom string) (*sdk.Int, e
 }
 
 func createDelegation(ctx sdk.Context, app *App, originalValidator string, newDelegatorRawAddr sdk.AccAddress, validator stakingtypes.Validator, originalTokens sdk.Int, tokensToDelegate sdk.Int, manifest *UpgradeManifest) error {
+	return createDelegationWithPolicy(ctx, app, originalValidator, newDelegatorRawAddr, validator, originalTokens, tokensToDelegate, "", manifest)
+}
+
+// withdrawExistingDelegationRewards withdraws rewards of delegation which already exists in destination chain,
+// otherwise distribution hooks would pay them out during delegation without being registered in the ledger
+func withdrawExistingDelegationRewards(ctx sdk.Context, app *App, delegatorRawAddr sdk.AccAddress, validator stakingtypes.Validator, manifest *UpgradeManifest) error {
+	if _, found := app.StakingKeeper.GetDelegation(ctx, delegatorRawAddr, validator.GetOperator()); !found {
+		return nil
+	}
+
+	withdrawAddr := app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, delegatorRawAddr)
+	err := getLedger(manifest).TrackBalances(distrtypes.ModuleName, withdrawAddr.String())
+	if err != nil {
+		return err
+	}
+
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delegatorRawAddr, validator.GetOperator())
+	if err != nil {
+		return fmt.Errorf("failed to withdraw rewards of existing delegation of %s to %s: %w", delegatorRawAddr.String(), validator.OperatorAddress, err)
+	}
+	if rewards.IsZero() {
+		return nil
+	}
+
+	return registerLedgerEntry(manifest, UpgradeLedgerEntry{
+		Domain:    LedgerDomainDestinationBank,
+		Debit:     distrtypes.ModuleName,
+		Credit:    withdrawAddr.String(),
+		Amount:    rewards,
+		Validator: validator.OperatorAddress,
+		Memo:      "delegation_rewards_withdrawal",
+	})
+}
+
+// createDelegationWithPolicy creates delegation, policy is set for delegations which do not recreate source delegation
+func createDelegationWithPolicy(ctx sdk.Context, app *App, originalValidator string, newDelegatorRawAddr sdk.AccAddress, validator stakingtypes.Validator, originalTokens sdk.Int, tokensToDelegate sdk.Int, policy string, manifest *UpgradeManifest) error {
+	memo := "delegation"
+	if policy != "" {
+		memo = policy
+	}
+
+	err := withdrawExistingDelegationRewards(ctx, app, newDelegatorRawAddr, validator, manifest)
+	if err != nil {
+		return err
+	}
+
+	err = registerLedgerEntry(manifest, UpgradeLedgerEntry{
+		Domain:    LedgerDomainDestinationStaking,
+		Debit:     newDelegatorRawAddr.String(),
+		Credit:    validator.OperatorAddress,
+		Amount:    sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokensToDelegate)),
+		Validator: validator.OperatorAddress,
+		Origin:    originalValidator,
+		Memo:      memo,
+	})
+	if err != nil {
+		return err
+	}
 
 	newShares, err := app.StakingKeeper.Delegate(ctx, newDelegatorRawAddr, tokensToDelegate, stakingtypes.Unbonded, validator, true)
 	if err != nil {
@@ -1159,9 +1272,19 @@ func createDelegation(ctx sdk.Context, app *App, originalValidator string, newDe
 		NewTokens:         tokensToDelegate,
 		NewShares:         newShares,
 		OriginalValidator: originalValidator,
+		Policy:            policy,
 	}
 	manifest.Delegate.Delegations = append(manifest.Delegate.Delegations, delegation)
 
+	if policy != "" {
+		if manifest.Delegate.AggregatedPolicyDelegatedAmount == nil {
+			manifest.Delegate.AggregatedPolicyDelegatedAmount = &tokensToDelegate
+		} else {
+			*manifest.Delegate.AggregatedPolicyDelegatedAmount = manifest.Delegate.AggregatedPolicyDelegatedAmount.Add(tokensToDelegate)
+		}
+		manifest.Delegate.NumberOfPolicyDelegations++
+	}
+
 	if manifest.Delegate.AggregatedDelegatedAmount == nil {
 		manifest.Delegate.AggregatedDelegatedAmount = &tokensToDelegate
 	} else {
@@ -1192,6 +1315,17 @@ func handleCommunityPoolBalance(ctx sdk.Context, app *App, genesisData *GenesisD
 			return err
 		}
 
+		err = registerLedgerEntry(manifest, UpgradeLedgerEntry{
+			Domain: LedgerDomainDestinationBank,
+			Debit:  RemainingDistributionBalanceAccount.RawAddress.String(),
+			Credit: distrtypes.ModuleName,
+			Amount: convertedCommunityPoolBalance,
+			Memo:   "community_pool_balance",
+		})
+		if err != nil {
+			return err
+		}
+
 		// Move balance to destination chain community pool
 		err = app.DistrKeeper.FundCommunityPool(ctx, convertedCommunityPoolBalance, RemainingDistributionBalanceAccount.RawAddress)
 		if err != nil {
@@ -1226,16 +1360,37 @@ func createGenesisDelegations(ctx sdk.Context, app *App, genesisData *GenesisDat
 			continue
 		}
 
+		// Delegations are converted the same way as balance of the delegator
+		var delegatorBalance sdk.Coins
+		if delegatorAccount, exists := genesisData.Accounts.Get(delegatorAddr); exists {
+			delegatorBalance = delegatorAccount.Balance
+		}
+		conversion, err := getAccountConversion(genesisData, cudosCfg, delegatorAddr, delegatorBalance, app.StakingKeeper.BondDenom(ctx))
+		if err != nil {
+			return err
+		}
+
 		for _, validatorOperatorStringAddr := range delegatorAddrMap.Keys() {
 			delegatedAmount := delegatorAddrMap.MustGet(validatorOperatorStringAddr)
 
-			destValidator, err := resolveDestinationValidator(ctx, app, validatorOperatorStringAddr, cudosCfg)
-			if err != nil {
-				return err
-			}
-
 			// Get int amount in native tokens
-			tokensToDelegate, err := convertAmount(app.StakingKeeper.BondDenom(ctx), genesisData, delegatedAmount, cudosCfg)
+			tokensToDelegate := conversion.convertAmount(sdk.NewCoin(genesisData.BondDenom, delegatedAmount))
+
+			// Delegations to jailed or tombstoned validators are handled by configured policy
+			validatorState, validatorPolicy := getSourceValidatorPolicy(genesisData, cudosCfg, validatorOperatorStringAddr)
+
+			var destValidator *stakingtypes.Validator
+			switch validatorPolicy {
+			case ValidatorPolicyRedelegate:
+				destValidator, err = resolveDestinationValidator(ctx, app, validatorOperatorStringAddr, cudosCfg)
+			case ValidatorPolicyBackup:
+				destValidator, err = resolveBackupValidator(ctx, app, cudosCfg)
+			case ValidatorPolicyLiquid:
+				registerLiquidatedDelegation(manifest, delegatorAddr, validatorOperatorStringAddr, validatorState, delegatedAmount, tokensToDelegate)
+				continue
+			default:
+				err = fmt.Errorf("unknown validator policy \"%s\"", validatorPolicy)
+			}
 			if err != nil {
 				return err
 			}
@@ -1260,10 +1415,16 @@ func createGenesisDelegations(ctx sdk.Context, app *App, genesisData *GenesisDat
 				return err
 			}
 
+			if validatorState != "" {
+				delegation := &manifest.Delegate.Delegations[len(manifest.Delegate.Delegations)-1]
+				delegation.SourceValidatorState = validatorState
+				delegation.ValidatorPolicy = validatorPolicy
+			}
+
 		}
 	}
 
-	return nil
+	return createPolicyDelegations(ctx, app, genesisData, cudosCfg, manifest)
 }
 
 func getCoinsFromInterfaceSlice(coins []interface{}) (sdk.Coins, error) {
@@ -1320,20 +1481,28 @@ func getInterfaceSliceFromCoins(coins sdk.Coins) []interface{} {
 }
 
 func withdrawGenesisContractBalances(genesisData *GenesisData, manifest *UpgradeManifest, cudosCfg *CudosMergeConfig) error {
+	err := verifyContractRoutesInGenesis(genesisData, cudosCfg)
+	if err != nil {
+		return err
+	}
 
 	for _, contractAddress := range genesisData.Contracts.Keys() {
-		resolvedAddress, err := resolveIfContractAddressWithFallback(contractAddress, genesisData.Contracts, cudosCfg)
+		recipients, routeDecision, err := resolveContractRecipients(contractAddress, genesisData.Contracts, cudosCfg)
 		if err != nil {
 			return err
 		}
 
+		movedParts := make([]sdk.Coins, len(recipients))
 		contractBalance, contractBalancePresent := genesisData.Accounts.Get(contractAddress)
 		if contractBalancePresent {
-			err := moveGenesisBalance(genesisData, contractAddress, resolvedAddress, contractBalance.Balance, "contract_balance", manifest, cudosCfg)
+			movedParts, err = moveGenesisBalanceToRecipients(genesisData, contractAddress, recipients, contractBalance.Balance, "contract_balance", manifest, cudosCfg)
 			if err != nil {
 				return err
 			}
 		}
+
+		// Routing decision is recorded for every contract, including the ones without balance
+		registerContractRouting(manifest, contractAddress, routeDecision, recipients, movedParts, contractRoutedBalance)
 	}
 
 	return nil
@@ -1565,22 +1734,6 @@ func parseGenesisWasmContracts(jsonData map[string]interface{}) (*OrderedMap[str
 	return contractAccountMap, nil
 }
 
-func resolveIfContractAddressWithFallback(address string, contracts *OrderedMap[string, *ContractInfo], cudosCfg *CudosMergeConfig) (string, error) {
-
-	resolvedAddress, err := resolveIfContractAddress(address, contracts)
-	if err != nil {
-		return "", err
-	}
-
-	if resolvedAddress == nil || strings.TrimSpace(*resolvedAddress) == "" {
-		// Use fallback address
-		return cudosCfg.Config.ContractDestinationFallbackAddr, nil
-	} else {
-		// Use resolved address
-		return *resolvedAddress, nil
-	}
-}
-
 func resolveIfContractAddress(address string, contracts *OrderedMap[string, *ContractInfo]) (*string, error) {
 	adminsMap := map[string]bool{}
 	creatorsMap := map[string]bool{}
@@ -1655,9 +1808,9 @@ func decodePubKeyFromMap(pubKeyMap map[string]interface{}) (cryptotypes.PubKey,
 		return &pubKey, nil
 
 	case "/cosmos.crypto.multisig.LegacyAminoPubKey":
-		threshold, ok := pubKeyMap["threshold"].(float64) // JSON numbers are float64
-		if !ok {
-			return nil, fmt.Errorf("threshold field not found or is not a number in pubKeyMap")
+		threshold, err := cast.ToUint32E(pubKeyMap["threshold"])
+		if err != nil {
+			return nil, fmt.Errorf("threshold field not found or is not a number in pubKeyMap: %w", err)
 		}
 
 		pubKeysInterface, ok := pubKeyMap["public_keys"].([]interface{})
@@ -1680,10 +1833,16 @@ func decodePubKeyFromMap(pubKeyMap map[string]interface{}) (cryptotypes.PubKey,
 			pubKeys = append(pubKeys, pubKey)
 		}
 
+		// Constructor panics on invalid threshold
+		if threshold == 0 || int(threshold) > len(pubKeys) {
+			return nil, fmt.Errorf("invalid multisig threshold %d of %d keys", threshold, len(pubKeys))
+		}
+
 		legacyAminoPubKey := multisig.NewLegacyAminoPubKey(int(threshold), pubKeys)
 		return legacyAminoPubKey, nil
 
 	default:
+		// secp256r1 is not supported either, since its JSON encoding fails in export of destination chain state
 		return nil, fmt.Errorf("unsupported key type: %s", keyType)
 	}
 }
@@ -1711,8 +1870,31 @@ func createNewNormalAccountFromBaseAccount(ctx sdk.Context, app *App, account *a
 }
 
 func migrateToAccount(ctx sdk.Context, app *App, fromAddress string, toAddress sdk.AccAddress, sourceCoins sdk.Coins, destCoins sdk.Coins, memo string, manifest *UpgradeManifest) error {
+	return migrateToAccountWithConversion(ctx, app, fromAddress, toAddress, sourceCoins, destCoins, nil, memo, manifest)
+}
+
+// migrateToAccountWithConversion mints balance converted with conversion of the source account, nil conversion means default conversion constants
+func migrateToAccountWithConversion(ctx sdk.Context, app *App, fromAddress string, toAddress sdk.AccAddress, sourceCoins sdk.Coins, destCoins sdk.Coins, conversion *accountConversion, memo string, manifest *UpgradeManifest) error {
+	entry := UpgradeLedgerEntry{
+		Domain:       LedgerDomainDestinationBank,
+		Debit:        minttypes.ModuleName,
+		Credit:       toAddress.String(),
+		Amount:       destCoins,
+		Origin:       fromAddress,
+		SourceAmount: sourceCoins,
+		Memo:         memo,
+	}
+	if conversion != nil && conversion.tier != nil {
+		entry.ConversionRates = conversion.ratePairs()
+		entry.ConversionCap = conversion.cap
+	}
+
+	err := registerLedgerEntry(manifest, entry)
+	if err != nil {
+		return err
+	}
 
-	err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, toAddress, destCoins)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, toAddress, destCoins)
 	if err != nil {
 		return err
 	}
@@ -1721,12 +1903,17 @@ func migrateToAccount(ctx sdk.Context, app *App, fromAddress string, toAddress s
 		manifest.Migration = &UpgradeMigation{}
 	}
 
+	// Ledger holds conversion rates which were actually applied
+	registeredEntry := manifest.Ledger.Entries[len(manifest.Ledger.Entries)-1]
 	migrate := UpgradeBalanceMovement{
-		From:          fromAddress,
-		To:            toAddress.String(),
-		SourceBalance: sourceCoins,
-		DestBalance:   destCoins,
-		Memo:          memo,
+		From:            fromAddress,
+		To:              toAddress.String(),
+		SourceBalance:   sourceCoins,
+		DestBalance:     destCoins,
+		ConversionTier:  conversion.tierName(),
+		ConversionRates: registeredEntry.ConversionRates,
+		CappedAmount:    registeredEntry.CappedAmount,
+		Memo:            memo,
 	}
 	manifest.Migration.Migrations = append(manifest.Migration.Migrations, migrate)
 
@@ -1753,23 +1940,19 @@ func markAccountAsMigrated(genesisData *GenesisData, accountAddress string) erro
 	return nil
 }
 
-func registerMintedBalanceMovement(fromAddress, toAddress string, sourceAmount sdk.Coins, destAmount sdk.Coins, memo string, manifest *UpgradeManifest) {
-
-	if manifest.MoveMintedBalance == nil {
-		manifest.MoveMintedBalance = &UpgradeMoveMintedBalance{}
-	}
-
-	movement := UpgradeBalanceMovement{
-		From:          fromAddress,
-		To:            toAddress,
-		SourceBalance: sourceAmount,
-		DestBalance:   destAmount,
-		Memo:          memo,
+func registerManifestMoveDelegations(fromAddress, toAddress, validatorAddress string, amount sdk.Coin, memo string, manifest *UpgradeManifest) error {
+	err := registerLedgerEntry(manifest, UpgradeLedgerEntry{
+		Domain:    LedgerDomainSourceDelegations,
+		Debit:     fromAddress,
+		Credit:    toAddress,
+		Amount:    sdk.NewCoins(amount),
+		Validator: validatorAddress,
+		Memo:      memo,
+	})
+	if err != nil {
+		return err
 	}
-	manifest.MoveMintedBalance.Movements = append(manifest.MoveMintedBalance.Movements, movement)
-}
 
-func registerManifestMoveDelegations(fromAddress, toAddress, validatorAddress string, amount sdk.Int, memo string, manifest *UpgradeManifest) {
 	if manifest.MoveDelegations == nil {
 		manifest.MoveDelegations = &UpgradeMoveDelegations{}
 	}
@@ -1778,11 +1961,13 @@ func registerManifestMoveDelegations(fromAddress, toAddress, validatorAddress st
 		From:      fromAddress,
 		To:        toAddress,
 		Validator: validatorAddress,
-		Tokens:    amount,
+		Tokens:    amount.Amount,
 		Memo:      memo,
 	}
 	manifest.MoveDelegations.Movements = append(manifest.MoveDelegations.Movements, movement)
 	manifest.MoveDelegations.NumberOfMovements = len(manifest.MoveDelegations.Movements)
+
+	return nil
 }
 
 func getDelegationData(genesisData *GenesisData, DelegatorAddress string, validatorAddress string) (*OrderedMap[string, sdk.Int], *sdk.Int) {
@@ -1839,11 +2024,24 @@ func moveGenesisDelegation(genesisData *GenesisData, fromDelegatorAddress, toDel
 		sourceValidatorsDelegations.Set(validatorAddress, sourceAmount.Sub(amount))
 	}
 
-	registerManifestMoveDelegations(fromDelegatorAddress, toDelegatorAddress, validatorAddress, amount, memo, manifest)
-	return nil
+	return registerManifestMoveDelegations(fromDelegatorAddress, toDelegatorAddress, validatorAddress, sdk.NewCoin(genesisData.BondDenom, amount), memo, manifest)
 }
 
-func registerManifestBalanceMovement(fromAddress, toAddress string, amount sdk.Coins, memo string, manifest *UpgradeManifest) {
+func registerManifestBalanceMovement(fromAddress, toAddress string, amount sdk.Coins, memo string, manifest *UpgradeManifest) error {
+	// Skipped movements do not move any balance, so they are recorded only in the manifest movements
+	if !amount.Empty() {
+		err := registerLedgerEntry(manifest, UpgradeLedgerEntry{
+			Domain: LedgerDomainSourceBank,
+			Debit:  ledgerAccount(fromAddress),
+			Credit: ledgerAccount(toAddress),
+			Amount: amount,
+			Memo:   memo,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	if manifest.MoveGenesisBalance == nil {
 		manifest.MoveGenesisBalance = &UpgradeMoveGenesisBalance{}
 	}
@@ -1859,6 +2057,7 @@ func registerManifestBalanceMovement(fromAddress, toAddress string, amount sdk.C
 	manifest.MoveGenesisBalance.AggregatedMovedAmount = manifest.MoveGenesisBalance.AggregatedMovedAmount.Add(amount...)
 	manifest.MoveGenesisBalance.NumberOfMovements = len(manifest.MoveGenesisBalance.Movements)
 
+	return nil
 }
 
 func markAccountBalanceAsMoved(genesisData *GenesisData, address string) {
@@ -1898,9 +2097,7 @@ func moveGenesisBalance(genesisData *GenesisData, fromAddress, toAddress string,
 
 	markAccountBalanceAsMoved(genesisData, fromAddress)
 	markAccountBalanceAsMoved(genesisData, toAddress)
-	registerManifestBalanceMovement(fromAddress, toAddress, amount, memo, manifest)
-
-	return nil
+	return registerManifestBalanceMovement(fromAddress, toAddress, amount, memo, manifest)
 }
 
 func createGenesisBalance(genesisData *GenesisData, toAddress string, amount sdk.Coins, memo string, manifest *UpgradeManifest) error {
@@ -1921,9 +2118,7 @@ func createGenesisBalance(genesisData *GenesisData, toAddress string, amount sdk
 	genesisData.Accounts.Set(toAddress, genesisToBalance)
 
 	markAccountBalanceAsMoved(genesisData, toAddress)
-	registerManifestBalanceMovement("", toAddress, amount, memo, manifest)
-
-	return nil
+	return registerManifestBalanceMovement("", toAddress, amount, memo, manifest)
 }
 
 func removeGenesisBalance(genesisData *GenesisData, address string, amount sdk.Coins, memo string, manifest *UpgradeManifest) error {
@@ -1942,9 +2137,7 @@ func removeGenesisBalance(genesisData *GenesisData, address string, amount sdk.C
 	genesisData.Accounts.Set(address, genesisAccount)
 
 	markAccountBalanceAsMoved(genesisData, address)
-	registerManifestBalanceMovement(address, "", amount, memo, manifest)
-
-	return nil
+	return registerManifestBalanceMovement(address, "", amount, memo, manifest)
 }
 
 func GetAddressByName(genesisAccounts *OrderedMap[string, *AccountInfo], name string) (string, error) {
@@ -2073,7 +2266,7 @@ func resolveNewBaseAccount(ctx sdk.Context, app *App, genesisAccount *AccountInf
 	return newBaseAccount, nil
 }
 
-func doRegularAccountMigration(ctx sdk.Context, app *App, genesisAccount *AccountInfo, existingAccount authtypes.AccountI, newBalance sdk.Coins, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+func doRegularAccountMigration(ctx sdk.Context, app *App, genesisAccount *AccountInfo, existingAccount authtypes.AccountI, newBalance sdk.Coins, conversion *accountConversion, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
 	// Get base account and check for public keys collision
 	newBaseAccount, err := resolveNewBaseAccount(ctx, app, genesisAccount, existingAccount)
 	if err != nil {
@@ -2083,21 +2276,15 @@ func doRegularAccountMigration(ctx sdk.Context, app *App, genesisAccount *Accoun
 	// If there is anything to mint
 	if newBalance != nil {
 
-		// Account is not vesting
-		if cudosCfg.NotVestedAccounts.Has(genesisAccount.Address) {
-			err := createNewNormalAccountFromBaseAccount(ctx, app, newBaseAccount)
-			if err != nil {
-				return err
-			}
-		} else {
-			// Account is vesting
-			err := createNewVestingAccountFromBaseAccount(ctx, app, newBaseAccount, newBalance, ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+cudosCfg.Config.VestingPeriod)
-			if err != nil {
-				return err
-			}
+		// Account is vesting unless it is listed in not vested accounts or its schedule already ended
+		schedule := resolveVestingSchedule(ctx.BlockTime().Unix(), cudosCfg, genesisAccount, newBalance)
+		err := createMigratedAccount(ctx, app, newBaseAccount, schedule)
+		if err != nil {
+			return err
 		}
+		registerVestingSchedule(manifest, schedule)
 
-		err = migrateToAccount(ctx, app, genesisAccount.Address, genesisAccount.RawAddress, genesisAccount.Balance, newBalance, "regular_account", manifest)
+		err = migrateToAccountWithConversion(ctx, app, genesisAccount.Address, genesisAccount.RawAddress, genesisAccount.Balance, newBalance, conversion, "regular_account", manifest)
 		if err != nil {
 			return err
 		}
@@ -2113,7 +2300,7 @@ func doRegularAccountMigration(ctx sdk.Context, app *App, genesisAccount *Accoun
 	return nil
 }
 
-func doCollisionMigration(ctx sdk.Context, app *App, genesisData *GenesisData, genesisAccount *AccountInfo, existingAccount authtypes.AccountI, newBalance sdk.Coins, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+func doCollisionMigration(ctx sdk.Context, app *App, genesisData *GenesisData, genesisAccount *AccountInfo, existingAccount authtypes.AccountI, newBalance sdk.Coins, conversion *accountConversion, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
 	// Keep existing account intact and move cudos balance to account specified in config
 	genesisData.CollisionMap.SetNew(genesisAccount.Address, cudosCfg.Config.VestingCollisionDestAddr)
 
@@ -2122,7 +2309,7 @@ func doCollisionMigration(ctx sdk.Context, app *App, genesisData *GenesisData, g
 		return err
 	}
 
-	err = migrateToAccount(ctx, app, genesisAccount.Address, destRawAddr, genesisAccount.Balance, newBalance, "vesting_collision_account", manifest)
+	err = migrateToAccountWithConversion(ctx, app, genesisAccount.Address, destRawAddr, genesisAccount.Balance, newBalance, conversion, "vesting_collision_account", manifest)
 	if err != nil {
 		return err
 	}
@@ -2143,20 +2330,19 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 		return err
 	}
 
-	totalSupplyReducedByCommission, err := convertBalance(app.StakingKeeper.BondDenom(ctx), totalCudosSupply, cudosCfg)
+	ledger := getLedger(manifest)
+	ledger.SetConversion(cudosCfg.BalanceConversionConstants, app.StakingKeeper.BondDenom(ctx))
+	err = ledger.AddEntry(UpgradeLedgerEntry{
+		Domain: LedgerDomainDestinationBank,
+		Debit:  LedgerExternalAccount,
+		Credit: minttypes.ModuleName,
+		Amount: totalSupplyToMint,
+		Memo:   "total_supply_mint",
+	})
 	if err != nil {
 		return err
 	}
 
-	totalCommission := totalSupplyToMint.Sub(totalSupplyReducedByCommission)
-
-	_, commissionRawAcc, err := bech32.DecodeAndConvert(cudosCfg.Config.CommissionFetchAddr)
-	if err != nil {
-		return fmt.Errorf("failed to get commission account raw Address: %w", err)
-	}
-
-	err = migrateToAccount(ctx, app, "mint_module", commissionRawAcc, sdk.NewCoins(), totalCommission, "total_commission", manifest)
-
 	extraSupplyInCudos := cudosCfg.Config.TotalCudosSupply.Sub(genesisData.TotalSupply.AmountOf(genesisData.BondDenom))
 	extraSupplyCudosAddress, err := ConvertAddressPrefix(cudosCfg.Config.ExtraSupplyFetchAddr, genesisData.Prefix)
 	if err != nil {
@@ -2175,8 +2361,37 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 		return fmt.Errorf("failed to handle community pool balance: %w", err)
 	}
 
+	totalSupplyReducedByCommission, err := convertBalance(app.StakingKeeper.BondDenom(ctx), totalCudosSupply, cudosCfg)
+	if err != nil {
+		return err
+	}
+
+	// Accounts converted with overridden constants receive more or less than default conversion, difference is covered by commission
+	conversionTiers, err := conversionTierAdjustment(genesisData, cudosCfg, app.StakingKeeper.BondDenom(ctx), nil)
+	if err != nil {
+		return err
+	}
+	if len(cudosCfg.Config.ConversionTiers) > 0 {
+		manifest.ConversionTiers = conversionTiers
+	}
+
+	totalCommission, isNegative := totalSupplyToMint.SafeSub(totalSupplyReducedByCommission.Add(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), conversionTiers.Adjustment)))
+	if isNegative {
+		return fmt.Errorf("total supply to mint %s is not sufficient to cover converted supply %s adjusted by conversion tiers %s", totalSupplyToMint.String(), totalSupplyReducedByCommission.String(), conversionTiers.Adjustment.String())
+	}
+
+	_, commissionRawAcc, err := bech32.DecodeAndConvert(cudosCfg.Config.CommissionFetchAddr)
+	if err != nil {
+		return fmt.Errorf("failed to get commission account raw Address: %w", err)
+	}
+
+	err = migrateToAccount(ctx, app, "mint_module", commissionRawAcc, sdk.NewCoins(), totalCommission, "total_commission", manifest)
+	if err != nil {
+		return err
+	}
+
 	// Mint the rest of the supply
-	for _, genesisAccountAddress := range genesisData.Accounts.Keys() {
+	for _, genesisAccountAddress := range getAccountsInMigrationOrder(genesisData.Accounts) {
 		genesisAccount := genesisData.Accounts.MustGet(genesisAccountAddress)
 
 		if genesisAccount.AccountType == ContractAccountType {
@@ -2216,6 +2431,19 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 			continue
 		}
 
+		// Accounts with negligible balance are settled at once after the loop
+		isDust, dustAmount, err := isDustAccount(genesisData, cudosCfg, genesisAccountAddress, genesisAccount, genesisAccount.Balance, app.StakingKeeper.BondDenom(ctx))
+		if err != nil {
+			return err
+		}
+		if isDust {
+			err = registerDustAccount(genesisData, cudosCfg, genesisAccount, dustAmount, manifest)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		existingAccount := app.AccountKeeper.GetAccount(ctx, genesisAccount.RawAddress)
 		existingAccountInfo, err := accountIToAccountInfo(existingAccount)
 		if err != nil {
@@ -2223,7 +2451,7 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 		}
 
 		// Get balance to mint
-		newBalance, err := convertBalance(app.StakingKeeper.BondDenom(ctx), genesisAccount.Balance, cudosCfg)
+		newBalance, conversion, err := resolveAccountConversion(genesisData, cudosCfg, genesisAccountAddress, genesisAccount, genesisAccount.Balance, app.StakingKeeper.BondDenom(ctx))
 		if err != nil {
 			return err
 		}
@@ -2234,12 +2462,13 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 			regularMigration = false
 		}
 
-		if genesisAccount.AccountType != BaseAccountType {
+		// Source vesting accounts are migrated regularly only if their remaining schedule is to be kept
+		if genesisAccount.AccountType != BaseAccountType && !canMigrateWithRemainingSchedule(cudosCfg, genesisAccount) {
 			regularMigration = false
 		}
 
 		if regularMigration {
-			err := doRegularAccountMigration(ctx, app, genesisAccount, existingAccount, newBalance, cudosCfg, manifest)
+			err := doRegularAccountMigration(ctx, app, genesisAccount, existingAccount, newBalance, conversion, cudosCfg, manifest)
 			if err != nil {
 				return fmt.Errorf("failed to migrate account %s: %w", genesisAccountAddress, err)
 			}
@@ -2250,7 +2479,7 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 			}
 
 			// New balance goes to foundation wallet
-			err = doCollisionMigration(ctx, app, genesisData, genesisAccount, existingAccount, newBalance, cudosCfg, manifest)
+			err = doCollisionMigration(ctx, app, genesisData, genesisAccount, existingAccount, newBalance, conversion, cudosCfg, manifest)
 			if err != nil {
 				return fmt.Errorf("failed to migrate account %s: %w", genesisAccountAddress, err)
 			}
@@ -2263,6 +2492,16 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 
 	}
 
+	err = settleDust(ctx, app, cudosCfg, manifest)
+	if err != nil {
+		return fmt.Errorf("failed to settle dust: %w", err)
+	}
+
+	err = settleRoundingRemainders(ctx, app, cudosCfg, manifest)
+	if err != nil {
+		return fmt.Errorf("failed to settle rounding remainders: %w", err)
+	}
+
 	// Move remaining mint module balance
 	remainingMintBalance := app.BankKeeper.GetAllBalances(ctx, mintModuleAddr)
 	remainingMintBalance = remainingMintBalance.Sub(initialMintBalance)
@@ -2273,13 +2512,12 @@ func MigrateGenesisAccounts(genesisData *GenesisData, ctx sdk.Context, app *App,
 	)
 
 	err = checkTolerance(remainingMintBalance, maxToleratedRemainingMintBalance)
+	registerToleranceCheck(manifest, "remaining_mint_module_balance", remainingMintBalance, maxToleratedRemainingMintBalance, err)
 	if err != nil {
 		return err
 	}
 
-	err = migrateToAccount(ctx, app, mintModuleAddr.String(), commissionRawAcc, sdk.NewCoins(), remainingMintBalance, "remaining_mint_module_balance", manifest)
-
-	return nil
+	return migrateToAccount(ctx, app, mintModuleAddr.String(), commissionRawAcc, sdk.NewCoins(), remainingMintBalance, "remaining_mint_module_balance", manifest)
 }
 
 func DoGenesisAccountMovements(genesisData *GenesisData, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
@@ -2287,19 +2525,28 @@ func DoGenesisAccountMovements(genesisData *GenesisData, cudosCfg *CudosMergeCon
 	for _, accountMovement := range cudosCfg.Config.MovedAccounts {
 		// Skip if source and destination address is the same
 		if accountMovement.SourceAddress == accountMovement.DestinationAddress {
-			registerManifestBalanceMovement(accountMovement.SourceAddress, accountMovement.DestinationAddress, nil, "movement_to_itself_skipping", manifest)
+			err := registerManifestBalanceMovement(accountMovement.SourceAddress, accountMovement.DestinationAddress, nil, "movement_to_itself_skipping", manifest)
+			if err != nil {
+				return err
+			}
 			continue
 		}
 
 		fromAcc, exists := genesisData.Accounts.Get(accountMovement.SourceAddress)
 
 		if !exists {
-			registerManifestBalanceMovement(accountMovement.SourceAddress, accountMovement.DestinationAddress, nil, "non_existing_from_account_skipping", manifest)
+			err := registerManifestBalanceMovement(accountMovement.SourceAddress, accountMovement.DestinationAddress, nil, "non_existing_from_account_skipping", manifest)
+			if err != nil {
+				return err
+			}
 			continue
 		}
 
 		if fromAcc.Balance.IsZero() {
-			registerManifestBalanceMovement(accountMovement.SourceAddress, accountMovement.DestinationAddress, nil, "no_source_balance_to_move_skipping", manifest)
+			err := registerManifestBalanceMovement(accountMovement.SourceAddress, accountMovement.DestinationAddress, nil, "no_source_balance_to_move_skipping", manifest)
+			if err != nil {
+				return err
+			}
 			continue
 		}
 
@@ -2360,17 +2607,60 @@ func parseGenesisTotalSupply(jsonData map[string]interface{}) (sdk.Coins, error)
 
 }
 
-func verifySupply(app *App, ctx sdk.Context, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+func verifySupply(app *App, ctx sdk.Context, cudosCfg *CudosMergeConfig, destinationSupplyBefore sdk.Coins, manifest *UpgradeManifest) error {
 
 	expectedMintedSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), cudosCfg.Config.TotalFetchSupplyToMint))
 
 	mintedSupply := manifest.Migration.AggregatedMigratedAmount
+	if manifest.Dust != nil {
+		// Burned dust was minted but did not end up in any account
+		mintedSupply = mintedSupply.Add(manifest.Dust.BurnedAmount...)
+	}
+
+	err := fillDestinationSupplyActuals(manifest, cudosCfg, app.StakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return err
+	}
+
+	if manifest.Ledger != nil {
+		err = manifest.Ledger.Verify()
+		if err != nil {
+			return err
+		}
+
+		ledgerMintedSupply := manifest.Ledger.DebitedAmount(LedgerDomainDestinationBank, minttypes.ModuleName)
+		if !isEqualCoins(ledgerMintedSupply, mintedSupply) {
+			return fmt.Errorf("ledger minted amount %s does not match migrated amount %s", ledgerMintedSupply.String(), mintedSupply.String())
+		}
+	}
+
+	// Accounts converted with overridden constants must have received exactly the amount accounted for in commission
+	if manifest.ConversionTiers != nil {
+		tierMigratedAmount := sdk.ZeroInt()
+		for _, migration := range manifest.Migration.Migrations {
+			if migration.ConversionTier != "" {
+				tierMigratedAmount = tierMigratedAmount.Add(migration.DestBalance.AmountOf(app.StakingKeeper.BondDenom(ctx)))
+			}
+		}
+
+		if !tierMigratedAmount.Equal(manifest.ConversionTiers.TierConvertedAmount) {
+			return fmt.Errorf("amount migrated with conversion tiers %s does not match expected amount %s", tierMigratedAmount.String(), manifest.ConversionTiers.TierConvertedAmount.String())
+		}
+	}
 
 	maximumDifference, ok := sdk.NewIntFromString("10000000000")
 	if !ok {
 		return fmt.Errorf("invalid maximum difference value")
 	}
 
+	manifest.SupplyVerification = &UpgradeSupplyVerification{
+		DestinationSupplyBefore: destinationSupplyBefore,
+		DestinationSupplyAfter:  sdk.NewCoins(app.BankKeeper.GetSupply(ctx, app.StakingKeeper.BondDenom(ctx))),
+		ExpectedMintedSupply:    expectedMintedSupply,
+		MintedSupply:            mintedSupply,
+		MaxToleratedDifference:  maximumDifference,
+	}
+
 	for _, expectedCoin := range expectedMintedSupply {
 		for _, mintedCoin := range mintedSupply {
 			if expectedCoin.Denom == mintedCoin.Denom {
@@ -2381,8 +2671,13 @@ func verifySupply(app *App, ctx sdk.Context, cudosCfg *CudosMergeConfig, manifes
 					difference = mintedCoin.Amount.Sub(expectedCoin.Amount)
 				}
 
+				var err error
 				if difference.GT(maximumDifference) {
-					return fmt.Errorf("Total supply is not correct, expected %s, got %s", expectedCoin.String(), mintedCoin.String())
+					err = fmt.Errorf("Total supply is not correct, expected %s, got %s", expectedCoin.String(), mintedCoin.String())
+				}
+				registerToleranceCheck(manifest, "minted_supply_difference", sdk.NewCoin(expectedCoin.Denom, difference), maximumDifference, err)
+				if err != nil {
+					return err
 				}
 
 			}
@@ -0,0 +1,318 @@
+package app
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"os"
+	"strings"
+)
+
+const (
+	// ContractRouteDestination sends everything held by the contract to explicit destination address
+	ContractRouteDestination = "destination"
+	// ContractRouteSplit splits everything held by the contract between beneficiaries by weight
+	ContractRouteSplit = "split"
+	// ContractRouteEscrow keeps everything held by the contract under new escrow address derived from the contract address
+	ContractRouteEscrow = "escrow"
+
+	// Decisions recorded in manifest for contracts without configured route
+	ContractRouteOwner    = "owner"
+	ContractRouteFallback = "fallback"
+
+	contractEscrowModuleName = "cudos-merge-escrow"
+)
+
+// ContractRoute overrides resolution of contract admin or creator for balance, delegations and gov deposits of the contract
+type ContractRoute struct {
+	Contract    string `json:"contract"`              // Cudos contract address
+	Type        string `json:"type"`                  // "destination", "split" or "escrow"
+	Destination string `json:"destination,omitempty"` // Cudos address, required for "destination" type
+
+	Beneficiaries          []Pair[string, sdk.Dec] `json:"beneficiaries,omitempty"`            // Cudos address -> weight, required for "split" type
+	BeneficiariesCSVPath   string                  `json:"beneficiaries_csv_path,omitempty"`   // External file with "address,weight" rows, relative path is resolved against directory of the config file
+	BeneficiariesCSVSha256 string                  `json:"beneficiaries_csv_sha256,omitempty"` // Expected sha256 of the external beneficiaries file, required if the path is set
+}
+
+// resolveBeneficiaries loads beneficiaries of the split route from the external file if given
+func (route *ContractRoute) resolveBeneficiaries(configDir string) error {
+	if route.BeneficiariesCSVPath == "" {
+		return nil
+	}
+	if route.Beneficiaries != nil {
+		return fmt.Errorf("beneficiaries of contract %s can not be provided both inline and as file", route.Contract)
+	}
+
+	csvFilePath, err := resolveConfigFilePath(route.BeneficiariesCSVPath, configDir)
+	if err != nil {
+		return err
+	}
+
+	beneficiaries, err := LoadContractBeneficiariesFromFile(csvFilePath, route.BeneficiariesCSVSha256)
+	if err != nil {
+		return fmt.Errorf("failed to load beneficiaries of contract %s: %w", route.Contract, err)
+	}
+	route.Beneficiaries = beneficiaries
+
+	return nil
+}
+
+func LoadContractBeneficiariesFromFile(csvFilePath string, expectedSha256Hex string) ([]Pair[string, sdk.Dec], error) {
+	if expectedSha256Hex == "" {
+		return nil, fmt.Errorf("expected sha256 of beneficiaries file \"%s\" is not set", csvFilePath)
+	}
+
+	csvData, err := os.ReadFile(csvFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read beneficiaries file: %w", err)
+	}
+
+	if isVerified, actualHashHex, err := VerifySha256(csvData, &expectedSha256Hex); err != nil {
+		return nil, err
+	} else if !isVerified {
+		return nil, fmt.Errorf("failed to verify sha256: beneficiaries file \"%s\" hash \"%s\" does not match expected hash \"%s\"", csvFilePath, actualHashHex, expectedSha256Hex)
+	}
+
+	r := csv.NewReader(bytes.NewReader(csvData))
+	r.FieldsPerRecord = 2
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading beneficiaries file \"%s\": %w", csvFilePath, err)
+	}
+
+	var beneficiaries []Pair[string, sdk.Dec]
+	for i, record := range records {
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight in row %d of beneficiaries file \"%s\": %w", i+1, csvFilePath, err)
+		}
+		beneficiaries = append(beneficiaries, Pair[string, sdk.Dec]{Key: strings.TrimSpace(record[0]), Value: weight})
+	}
+
+	return beneficiaries, nil
+}
+
+// getContractEscrowAddress derives escrow address of the contract, the address has the same prefix as the contract and
+// no private key, so nobody can spend from it until it is released by governance
+func getContractEscrowAddress(contract string) (string, error) {
+	prefix, contractRawAddr, err := bech32.DecodeAndConvert(contract)
+	if err != nil {
+		return "", fmt.Errorf("decoding of the '%s' contract address failed: %w", contract, err)
+	}
+	return bech32.ConvertAndEncode(prefix, address.Module(contractEscrowModuleName, contractRawAddr))
+}
+
+func verifyContractRoutes(cudosCfg *CudosMergeConfig, sourceAddrPrefix string) error {
+	contracts := NewOrderedMap[string, bool]()
+
+	for _, route := range cudosCfg.Config.ContractRoutes {
+		if err := verifyAddress(route.Contract, &sourceAddrPrefix); err != nil {
+			return fmt.Errorf("contract route: %w", err)
+		}
+		if contracts.Has(route.Contract) {
+			return fmt.Errorf("contract route: contract %s is listed more than once", route.Contract)
+		}
+		contracts.Set(route.Contract, true)
+
+		if route.Type != ContractRouteDestination && route.Destination != "" {
+			return fmt.Errorf("route of contract %s: destination can be set only for \"%s\" type", route.Contract, ContractRouteDestination)
+		}
+		if route.Type != ContractRouteSplit && len(route.Beneficiaries) > 0 {
+			return fmt.Errorf("route of contract %s: beneficiaries can be set only for \"%s\" type", route.Contract, ContractRouteSplit)
+		}
+
+		switch route.Type {
+		case ContractRouteDestination:
+			if err := verifyAddress(route.Destination, &sourceAddrPrefix); err != nil {
+				return fmt.Errorf("route of contract %s: destination address error: %w", route.Contract, err)
+			}
+		case ContractRouteEscrow:
+		case ContractRouteSplit:
+			if len(route.Beneficiaries) == 0 {
+				return fmt.Errorf("route of contract %s: list of beneficiaries is empty", route.Contract)
+			}
+			beneficiaries := NewOrderedMap[string, bool]()
+			for _, beneficiary := range route.Beneficiaries {
+				if err := verifyAddress(beneficiary.Key, &sourceAddrPrefix); err != nil {
+					return fmt.Errorf("route of contract %s: %w", route.Contract, err)
+				}
+				if beneficiaries.Has(beneficiary.Key) {
+					return fmt.Errorf("route of contract %s: beneficiary %s is listed more than once", route.Contract, beneficiary.Key)
+				}
+				beneficiaries.Set(beneficiary.Key, true)
+				if !beneficiary.Value.IsPositive() {
+					return fmt.Errorf("route of contract %s: weight %s of beneficiary %s is not positive", route.Contract, beneficiary.Value, beneficiary.Key)
+				}
+			}
+		default:
+			return fmt.Errorf("route of contract %s: unknown route type \"%s\"", route.Contract, route.Type)
+		}
+	}
+
+	return nil
+}
+
+// verifyContractRoutesInGenesis checks that routed contracts exist, escrow addresses are new and nothing is routed
+// back to a contract
+func verifyContractRoutesInGenesis(genesisData *GenesisData, cudosCfg *CudosMergeConfig) error {
+	for _, route := range cudosCfg.Config.ContractRoutes {
+		if !genesisData.Contracts.Has(route.Contract) {
+			return fmt.Errorf("routed contract %s does not exist in genesis", route.Contract)
+		}
+
+		if route.Type == ContractRouteEscrow {
+			escrowAddr, err := getContractEscrowAddress(route.Contract)
+			if err != nil {
+				return err
+			}
+			if genesisData.Accounts.Has(escrowAddr) {
+				return fmt.Errorf("escrow address %s of contract %s already exists in genesis", escrowAddr, route.Contract)
+			}
+		}
+
+		recipients, _, err := resolveContractRecipients(route.Contract, genesisData.Contracts, cudosCfg)
+		if err != nil {
+			return err
+		}
+		for _, recipient := range recipients {
+			if genesisData.Contracts.Has(recipient.Key) {
+				return fmt.Errorf("contract %s is routed to contract %s", route.Contract, recipient.Key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveContractRecipients returns weighted recipients of everything held by the address together with routing decision,
+// address which is not a contract is its own only recipient with empty decision
+func resolveContractRecipients(address string, contracts *OrderedMap[string, *ContractInfo], cudosCfg *CudosMergeConfig) ([]Pair[string, sdk.Dec], string, error) {
+	if !contracts.Has(address) {
+		return []Pair[string, sdk.Dec]{{Key: address, Value: sdk.OneDec()}}, "", nil
+	}
+
+	if route, exists := cudosCfg.ContractRoutes.Get(address); exists {
+		switch route.Type {
+		case ContractRouteDestination:
+			return []Pair[string, sdk.Dec]{{Key: route.Destination, Value: sdk.OneDec()}}, route.Type, nil
+		case ContractRouteEscrow:
+			escrowAddr, err := getContractEscrowAddress(address)
+			if err != nil {
+				return nil, "", err
+			}
+			return []Pair[string, sdk.Dec]{{Key: escrowAddr, Value: sdk.OneDec()}}, route.Type, nil
+		case ContractRouteSplit:
+			return route.Beneficiaries, route.Type, nil
+		default:
+			return nil, "", fmt.Errorf("unknown route type \"%s\" of contract %s", route.Type, address)
+		}
+	}
+
+	resolvedAddress, err := resolveIfContractAddress(address, contracts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if resolvedAddress == nil || strings.TrimSpace(*resolvedAddress) == "" {
+		return []Pair[string, sdk.Dec]{{Key: cudosCfg.Config.ContractDestinationFallbackAddr, Value: sdk.OneDec()}}, ContractRouteFallback, nil
+	}
+
+	return []Pair[string, sdk.Dec]{{Key: *resolvedAddress, Value: sdk.OneDec()}}, ContractRouteOwner, nil
+}
+
+// splitCoinsByWeight splits every coin separately, truncation remainders go to the last recipient
+func splitCoinsByWeight(coins sdk.Coins, weights []Pair[string, sdk.Dec]) []sdk.Coins {
+	parts := make([]sdk.Coins, len(weights))
+	for i := range parts {
+		parts[i] = sdk.NewCoins()
+	}
+
+	for _, coin := range coins {
+		for i, amount := range splitByWeight(coin.Amount, weights) {
+			parts[i] = parts[i].Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return parts
+}
+
+// moveGenesisBalanceToRecipients moves amount to the recipients split by their weights and returns moved parts
+func moveGenesisBalanceToRecipients(genesisData *GenesisData, fromAddress string, recipients []Pair[string, sdk.Dec], amount sdk.Coins, memo string, manifest *UpgradeManifest, cudosCfg *CudosMergeConfig) ([]sdk.Coins, error) {
+	parts := splitCoinsByWeight(amount, recipients)
+
+	for i, part := range parts {
+		if part.IsZero() {
+			continue
+		}
+
+		err := moveGenesisBalance(genesisData, fromAddress, recipients[i].Key, part, memo, manifest, cudosCfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return parts, nil
+}
+
+func getContractRouteRecord(manifest *UpgradeManifest, contract string, decision string, recipients []Pair[string, sdk.Dec]) *UpgradeContractRoute {
+	if manifest.ContractRouting == nil {
+		manifest.ContractRouting = &UpgradeContractRouting{}
+	}
+
+	for i := range manifest.ContractRouting.Contracts {
+		if manifest.ContractRouting.Contracts[i].Contract == contract {
+			return &manifest.ContractRouting.Contracts[i]
+		}
+	}
+
+	record := UpgradeContractRoute{
+		Contract:         contract,
+		Route:            decision,
+		RoutedBalance:    sdk.NewCoins(),
+		RoutedDelegation: sdk.NewCoins(),
+		RoutedGovDeposit: sdk.NewCoins(),
+	}
+	for _, recipient := range recipients {
+		record.Recipients = append(record.Recipients, UpgradeContractRouteRecipient{
+			Address:    recipient.Key,
+			Weight:     recipient.Value,
+			Balance:    sdk.NewCoins(),
+			Delegation: sdk.NewCoins(),
+			GovDeposit: sdk.NewCoins(),
+		})
+	}
+
+	manifest.ContractRouting.Contracts = append(manifest.ContractRouting.Contracts, record)
+	manifest.ContractRouting.NumberOfContracts = len(manifest.ContractRouting.Contracts)
+
+	return &manifest.ContractRouting.Contracts[len(manifest.ContractRouting.Contracts)-1]
+}
+
+const (
+	contractRoutedBalance    = "balance"
+	contractRoutedDelegation = "delegation"
+	contractRoutedGovDeposit = "gov_deposit"
+)
+
+// registerContractRouting records parts of the contract balance, delegations or gov deposits moved to its recipients
+func registerContractRouting(manifest *UpgradeManifest, contract string, decision string, recipients []Pair[string, sdk.Dec], parts []sdk.Coins, kind string) {
+	record := getContractRouteRecord(manifest, contract, decision, recipients)
+
+	for i, part := range parts {
+		recipient := &record.Recipients[i]
+		switch kind {
+		case contractRoutedBalance:
+			recipient.Balance = recipient.Balance.Add(part...)
+			record.RoutedBalance = record.RoutedBalance.Add(part...)
+		case contractRoutedDelegation:
+			recipient.Delegation = recipient.Delegation.Add(part...)
+			record.RoutedDelegation = record.RoutedDelegation.Add(part...)
+		case contractRoutedGovDeposit:
+			recipient.GovDeposit = recipient.GovDeposit.Add(part...)
+			record.RoutedGovDeposit = record.RoutedGovDeposit.Add(part...)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConversionTier overrides conversion constants, and optionally caps converted amount, for selected source accounts.
+// Account listed by address uses the first tier which lists it, other accounts use the first tier listing their account type.
+type ConversionTier struct {
+	Name         string                  `json:"name"`
+	Addresses    []string                `json:"addresses,omitempty"`     // Source chain addresses
+	AccountTypes []AccountType           `json:"account_types,omitempty"` // Source account types, e.g. continuous_vesting_acc
+	Rates        []Pair[string, sdk.Dec] `json:"rates,omitempty"`         // Overrides balance conversion constants, denoms which are not listed use the default constant
+	Cap          *sdk.Int                `json:"cap,omitempty"`           // Maximal converted amount per account in destination denom
+}
+
+// accountConversion holds conversion of a single account balance
+type accountConversion struct {
+	tier  *ConversionTier
+	rates *OrderedMap[string, sdk.Dec]
+	cap   *sdk.Int
+	scale *sdk.Dec // Ratio of capped and uncapped converted amount, set only if cap was applied
+}
+
+func isKnownAccountType(accountType AccountType) bool {
+	switch accountType {
+	case BaseAccountType, DelayedVestingAccountType, ContinuousVestingAccountType, PermanentLockedAccountType, PeriodicVestingAccountType:
+		return true
+	}
+	return false
+}
+
+func verifyConversionTiers(cudosCfg *CudosMergeConfig, sourceAddrPrefix string) error {
+	tierAddresses := NewOrderedMap[string, string]()
+
+	for i, tier := range cudosCfg.Config.ConversionTiers {
+		if tier.Name == "" {
+			return fmt.Errorf("conversion tier %d: name is not set", i)
+		}
+		if len(tier.Addresses) == 0 && len(tier.AccountTypes) == 0 {
+			return fmt.Errorf("conversion tier %s: neither addresses nor account types are set", tier.Name)
+		}
+		if len(tier.Rates) == 0 && tier.Cap == nil {
+			return fmt.Errorf("conversion tier %s: neither rates nor cap are set", tier.Name)
+		}
+
+		for _, address := range tier.Addresses {
+			if err := verifyAddress(address, &sourceAddrPrefix); err != nil {
+				return fmt.Errorf("conversion tier %s: %w", tier.Name, err)
+			}
+			if otherTier, exists := tierAddresses.Get(address); exists {
+				return fmt.Errorf("conversion tier %s: address %s is already listed in tier %s", tier.Name, address, otherTier)
+			}
+			tierAddresses.Set(address, tier.Name)
+		}
+
+		for _, accountType := range tier.AccountTypes {
+			if !isKnownAccountType(accountType) {
+				return fmt.Errorf("conversion tier %s: account type \"%s\" is not supported", tier.Name, accountType)
+			}
+		}
+
+		for _, rate := range tier.Rates {
+			if !cudosCfg.BalanceConversionConstants.Has(rate.Key) {
+				return fmt.Errorf("conversion tier %s: denom %s has no default conversion constant", tier.Name, rate.Key)
+			}
+			if !rate.Value.IsPositive() {
+				return fmt.Errorf("conversion tier %s: conversion constant %s for denom %s is not positive", tier.Name, rate.Value, rate.Key)
+			}
+		}
+
+		if tier.Cap != nil && tier.Cap.IsNegative() {
+			return fmt.Errorf("conversion tier %s: negative cap %s", tier.Name, tier.Cap)
+		}
+	}
+
+	return nil
+}
+
+// resolveConversionTier returns conversion tier of the source account, nil if default conversion applies.
+// Extra supply account is always converted with default constants, since it is accounted for separately.
+func resolveConversionTier(genesisData *GenesisData, cudosCfg *CudosMergeConfig, address string, accountType AccountType) (*ConversionTier, error) {
+	if len(cudosCfg.Config.ConversionTiers) == 0 {
+		return nil, nil
+	}
+
+	extraSupplyCudosAddress, err := ConvertAddressPrefix(cudosCfg.Config.ExtraSupplyFetchAddr, genesisData.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	if address == extraSupplyCudosAddress {
+		return nil, nil
+	}
+
+	for i := range cudosCfg.Config.ConversionTiers {
+		tier := &cudosCfg.Config.ConversionTiers[i]
+		for _, tierAddress := range tier.Addresses {
+			if tierAddress == address {
+				return tier, nil
+			}
+		}
+	}
+
+	for i := range cudosCfg.Config.ConversionTiers {
+		tier := &cudosCfg.Config.ConversionTiers[i]
+		for _, tierAccountType := range tier.AccountTypes {
+			if tierAccountType == accountType {
+				return tier, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func newAccountConversion(cudosCfg *CudosMergeConfig, tier *ConversionTier) *accountConversion {
+	conversion := &accountConversion{tier: tier, rates: cudosCfg.BalanceConversionConstants}
+	if tier == nil {
+		return conversion
+	}
+
+	conversion.rates = NewOrderedMap[string, sdk.Dec]()
+	for i := range cudosCfg.BalanceConversionConstants.Iterate() {
+		conversion.rates.Set(i.Key, i.Value)
+	}
+	for _, rate := range tier.Rates {
+		conversion.rates.Set(rate.Key, rate.Value)
+	}
+	conversion.cap = tier.Cap
+
+	return conversion
+}
+
+func (c *accountConversion) tierName() string {
+	if c == nil || c.tier == nil {
+		return ""
+	}
+	return c.tier.Name
+}
+
+func (c *accountConversion) ratePairs() []Pair[string, sdk.Dec] {
+	if c == nil || c.tier == nil {
+		return nil
+	}
+	var pairs []Pair[string, sdk.Dec]
+	for i := range c.rates.Iterate() {
+		pairs = append(pairs, i)
+	}
+	return pairs
+}
+
+// convertBalance converts balance with the account rates and applies the cap, denominations without rate are ignored
+func (c *accountConversion) convertBalance(outputDenom string, balance sdk.Coins) sdk.Coins {
+	exactAmount := sdk.ZeroDec()
+	amount := sdk.ZeroInt()
+	numberOfConvertedCoins := 0
+	for _, coin := range balance {
+		if conversionConstant, exists := c.rates.Get(coin.Denom); exists {
+			converted := coin.Amount.ToDec().Quo(conversionConstant)
+			exactAmount = exactAmount.Add(converted)
+			amount = amount.Add(converted.TruncateInt())
+			numberOfConvertedCoins++
+		}
+		// Denominations that are not in conversion constant map are ignored
+	}
+
+	c.scale = nil
+	if c.cap != nil && exactAmount.GT(c.cap.ToDec()) {
+		scale := c.cap.ToDec().Quo(exactAmount)
+		c.scale = &scale
+		amount = *c.cap
+	}
+
+	// Same result shape as convertBalance, so that empty balances are handled the same way
+	var resBalance sdk.Coins
+	if numberOfConvertedCoins > 0 {
+		resBalance = resBalance.Add(sdk.NewCoin(outputDenom, amount))
+	}
+	return resBalance
+}
+
+// convertAmount converts part of the account balance, e.g. its delegation, proportionally to the capped balance
+func (c *accountConversion) convertAmount(coin sdk.Coin) sdk.Int {
+	conversionConstant, exists := c.rates.Get(coin.Denom)
+	if !exists {
+		return sdk.ZeroInt()
+	}
+	converted := coin.Amount.ToDec().Quo(conversionConstant)
+	if c.scale != nil {
+		converted = converted.Mul(*c.scale)
+	}
+	return converted.TruncateInt()
+}
+
+// resolveAccountConversion converts balance of the source account using its conversion tier
+func resolveAccountConversion(genesisData *GenesisData, cudosCfg *CudosMergeConfig, address string, account *AccountInfo, balance sdk.Coins, outputDenom string) (sdk.Coins, *accountConversion, error) {
+	var tier *ConversionTier
+	var err error
+	if account != nil {
+		tier, err = resolveConversionTier(genesisData, cudosCfg, address, account.AccountType)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if tier == nil {
+		newBalance, err := convertBalance(outputDenom, balance, cudosCfg)
+		return newBalance, newAccountConversion(cudosCfg, nil), err
+	}
+
+	conversion := newAccountConversion(cudosCfg, tier)
+	return conversion.convertBalance(outputDenom, balance), conversion, nil
+}
+
+func isConvertedAccountType(accountType AccountType) bool {
+	return accountType != ContractAccountType && accountType != ModuleAccountType && accountType != IBCAccountType
+}
+
+// getAccountConversion resolves conversion of the source account, balance is used to determine whether the cap applies
+func getAccountConversion(genesisData *GenesisData, cudosCfg *CudosMergeConfig, address string, balance sdk.Coins, outputDenom string) (*accountConversion, error) {
+	account, exists := genesisData.Accounts.Get(address)
+	if !exists {
+		return newAccountConversion(cudosCfg, nil), nil
+	}
+
+	_, conversion, err := resolveAccountConversion(genesisData, cudosCfg, address, account, balance, outputDenom)
+	return conversion, err
+}
+
+// conversionTierAdjustment calculates difference between amounts converted with conversion tiers and with default constants
+// over all accounts converted by tiers. Balances given in balanceOverrides are used instead of current account balances.
+func conversionTierAdjustment(genesisData *GenesisData, cudosCfg *CudosMergeConfig, outputDenom string, balanceOverrides *OrderedMap[string, sdk.Coins]) (*UpgradeConversionTiers, error) {
+	res := &UpgradeConversionTiers{
+		DefaultConvertedAmount: sdk.ZeroInt(),
+		TierConvertedAmount:    sdk.ZeroInt(),
+	}
+
+	tierTotals := NewOrderedMap[string, *UpgradeConversionTierTotals]()
+	for _, tier := range cudosCfg.Config.ConversionTiers {
+		tierTotals.Set(tier.Name, &UpgradeConversionTierTotals{
+			Name:                   tier.Name,
+			DefaultConvertedAmount: sdk.ZeroInt(),
+			TierConvertedAmount:    sdk.ZeroInt(),
+		})
+	}
+
+	addAccount := func(address string, account *AccountInfo, balance sdk.Coins) error {
+		if !isConvertedAccountType(account.AccountType) {
+			return nil
+		}
+
+		tierBalance, conversion, err := resolveAccountConversion(genesisData, cudosCfg, address, account, balance, outputDenom)
+		if err != nil {
+			return err
+		}
+		if conversion.tier == nil {
+			return nil
+		}
+
+		defaultBalance, err := convertBalance(outputDenom, balance, cudosCfg)
+		if err != nil {
+			return err
+		}
+
+		totals := tierTotals.MustGet(conversion.tier.Name)
+		totals.NumberOfAccounts++
+		if conversion.scale != nil {
+			totals.NumberOfCappedAccounts++
+		}
+		totals.DefaultConvertedAmount = totals.DefaultConvertedAmount.Add(defaultBalance.AmountOf(outputDenom))
+		totals.TierConvertedAmount = totals.TierConvertedAmount.Add(tierBalance.AmountOf(outputDenom))
+		return nil
+	}
+
+	if balanceOverrides == nil {
+		balanceOverrides = NewOrderedMap[string, sdk.Coins]()
+	}
+
+	for i := range genesisData.Accounts.Iterate() {
+		address, account := i.Key, i.Value
+		balance := account.Balance
+		if overriddenBalance, exists := balanceOverrides.Get(address); exists {
+			balance = overriddenBalance
+		}
+		if err := addAccount(address, account, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	// Accounts which do not exist yet are created as base accounts when balance is moved to them
+	for i := range balanceOverrides.Iterate() {
+		address, balance := i.Key, i.Value
+		if genesisData.Accounts.Has(address) {
+			continue
+		}
+		if err := addAccount(address, &AccountInfo{Address: address, AccountType: BaseAccountType}, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range tierTotals.Iterate() {
+		totals := i.Value
+		res.Tiers = append(res.Tiers, totals)
+		res.NumberOfAccounts += totals.NumberOfAccounts
+		res.DefaultConvertedAmount = res.DefaultConvertedAmount.Add(totals.DefaultConvertedAmount)
+		res.TierConvertedAmount = res.TierConvertedAmount.Add(totals.TierConvertedAmount)
+	}
+	res.Adjustment = res.TierConvertedAmount.Sub(res.DefaultConvertedAmount)
+
+	return res, nil
+}
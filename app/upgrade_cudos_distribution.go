@@ -360,7 +360,7 @@ func checkTolerance(coins sdk.Coins, maxToleratedDiff sdk.Int) error {
 	return nil
 }
 
-func verifyOutstandingBalances(genesisData *GenesisData, cudosCfg *CudosMergeConfig) error {
+func verifyOutstandingBalances(genesisData *GenesisData, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
 
 	maxToleratedRemainingDistributionBalance := unwrapOrDefault(
 		cudosCfg.Config.MaxToleratedRemainingDistributionBalance,
@@ -375,6 +375,7 @@ func verifyOutstandingBalances(genesisData *GenesisData, cudosCfg *CudosMergeCon
 		diff := validatorOutstandingReward.Sub(validatorAccumulatedCommission)
 
 		err := checkDecTolerance(diff, maxToleratedRemainingDistributionBalance)
+		registerToleranceCheck(manifest, fmt.Sprintf("outstanding_rewards_%s", validatorAddr), diff, maxToleratedRemainingDistributionBalance, err)
 		if err != nil {
 			return fmt.Errorf("outstanding balance of validator %s is too high: %w", validatorAddr, err)
 		}
@@ -399,7 +400,7 @@ func withdrawGenesisDistributionRewards(logger log.Logger, genesisData *GenesisD
 	}
 
 	// Check that remaining balance is equal to AccumulatedCommissions
-	err := verifyOutstandingBalances(genesisData, cudosCfg)
+	err := verifyOutstandingBalances(genesisData, cudosCfg, manifest)
 	if err != nil {
 		return err
 	}
@@ -428,6 +429,7 @@ func withdrawGenesisDistributionRewards(logger log.Logger, genesisData *GenesisD
 	)
 
 	err = checkTolerance(remainingBalance, maxToleratedRemainingDistributionBalance)
+	registerToleranceCheck(manifest, "remaining_distribution_module_balance", remainingBalance, maxToleratedRemainingDistributionBalance, err)
 	if err != nil {
 		return fmt.Errorf("remaining distribution balance %s is too high", remainingBalance.String())
 	}
@@ -667,7 +669,7 @@ func withdrawDelegationRewards(logger log.Logger, genesisData *GenesisData, vali
 	// defensive edge case may happen on the very final digits
 	// of the decCoins due to operation order of the distribution mechanism.
 	rewards := rewardsRaw.Intersect(outstanding)
-	if !rewards.IsEqual(rewardsRaw) {
+	if !isEqualDecCoins(rewards, rewardsRaw) {
 		if logger != nil {
 			logger.Error(
 				"rounding error withdrawing rewards from validator",
@@ -0,0 +1,197 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const (
+	DustPolicyAggregate = "aggregate"
+	DustPolicyBurn      = "burn"
+
+	// Mint module account can not burn, dust is burned through dedicated module account with burner permission only
+	dustBurnerModuleName = "cudos_merge_dust_burner"
+)
+
+func getDustPolicy(cudosCfg *CudosMergeConfig) string {
+	return cudosCfg.Config.DustPolicy
+}
+
+func verifyDustConfig(cudosCfg *CudosMergeConfig, destAddrPrefix string) error {
+	if cudosCfg.Config.DustThreshold == nil {
+		if cudosCfg.Config.DustPolicy != "" || cudosCfg.Config.DustDestAddr != "" {
+			return fmt.Errorf("dust policy or destination address is set without dust threshold")
+		}
+		return nil
+	}
+
+	if cudosCfg.Config.DustThreshold.IsNegative() {
+		return fmt.Errorf("dust threshold %s is negative", cudosCfg.Config.DustThreshold)
+	}
+
+	switch cudosCfg.Config.DustPolicy {
+	case DustPolicyAggregate:
+		err := verifyAddress(cudosCfg.Config.DustDestAddr, &destAddrPrefix)
+		if err != nil {
+			return fmt.Errorf("dust destination address error: %v", err)
+		}
+	case DustPolicyBurn:
+		if cudosCfg.Config.DustDestAddr != "" {
+			return fmt.Errorf("dust destination address can not be used with \"%s\" policy", DustPolicyBurn)
+		}
+	case "":
+		return fmt.Errorf("dust policy must be set explicitly if dust threshold is set, one of \"%s\", \"%s\"", DustPolicyAggregate, DustPolicyBurn)
+	default:
+		return fmt.Errorf("unknown dust policy \"%s\"", cudosCfg.Config.DustPolicy)
+	}
+
+	return nil
+}
+
+// isDustAccount decides whether the source account is migrated as dust instead of creating destination account.
+// Only base accounts converted with default constants and without delegations to create are considered, contract escrow
+// accounts are never dust.
+func isDustAccount(genesisData *GenesisData, cudosCfg *CudosMergeConfig, address string, account *AccountInfo, balance sdk.Coins, destDenom string) (bool, sdk.Int, error) {
+	if cudosCfg.Config.DustThreshold == nil || account.AccountType != BaseAccountType || cudosCfg.EscrowAddresses.Has(address) {
+		return false, sdk.ZeroInt(), nil
+	}
+
+	if genesisData.Delegations.Has(address) && !cudosCfg.NotDelegatedAccounts.Has(address) || cudosCfg.PolicyDelegations.Has(address) {
+		return false, sdk.ZeroInt(), nil
+	}
+
+	tierBalance, conversion, err := resolveAccountConversion(genesisData, cudosCfg, address, account, balance, destDenom)
+	if err != nil {
+		return false, sdk.ZeroInt(), err
+	}
+	if conversion.tier != nil {
+		return false, sdk.ZeroInt(), nil
+	}
+
+	extraSupplyCudosAddress, err := ConvertAddressPrefix(cudosCfg.Config.ExtraSupplyFetchAddr, genesisData.Prefix)
+	if err != nil {
+		return false, sdk.ZeroInt(), err
+	}
+	if address == extraSupplyCudosAddress {
+		return false, sdk.ZeroInt(), nil
+	}
+
+	amount := tierBalance.AmountOf(destDenom)
+	return amount.LT(*cudosCfg.Config.DustThreshold), amount, nil
+}
+
+// getDustSourceBalance returns aggregated source balance of all dust accounts, balances given in balanceOverrides are used
+// instead of current account balances
+func getDustSourceBalance(genesisData *GenesisData, cudosCfg *CudosMergeConfig, destDenom string, balanceOverrides *OrderedMap[string, sdk.Coins]) (sdk.Coins, error) {
+	dustBalance := sdk.NewCoins()
+	if cudosCfg.Config.DustThreshold == nil {
+		return dustBalance, nil
+	}
+
+	for i := range genesisData.Accounts.Iterate() {
+		address, account := i.Key, i.Value
+		balance := account.Balance
+		if overriddenBalance, exists := balanceOverrides.Get(address); exists {
+			balance = overriddenBalance
+		}
+
+		isDust, _, err := isDustAccount(genesisData, cudosCfg, address, account, balance, destDenom)
+		if err != nil {
+			return nil, err
+		}
+		if isDust {
+			dustBalance = dustBalance.Add(balance...)
+		}
+	}
+
+	return dustBalance, nil
+}
+
+func registerDustAccount(genesisData *GenesisData, cudosCfg *CudosMergeConfig, account *AccountInfo, convertedAmount sdk.Int, manifest *UpgradeManifest) error {
+	if manifest.Dust == nil {
+		manifest.Dust = &UpgradeDust{
+			Threshold:   *cudosCfg.Config.DustThreshold,
+			Policy:      getDustPolicy(cudosCfg),
+			DestAddress: cudosCfg.Config.DustDestAddr,
+		}
+	}
+
+	manifest.Dust.Accounts = append(manifest.Dust.Accounts, UpgradeDustAccount{
+		Address:         account.Address,
+		SourceBalance:   account.Balance,
+		ConvertedAmount: convertedAmount,
+	})
+	manifest.Dust.NumberOfAccounts = len(manifest.Dust.Accounts)
+	manifest.Dust.AggregatedSourceBalance = manifest.Dust.AggregatedSourceBalance.Add(account.Balance...)
+
+	return markAccountAsMigrated(genesisData, account.Address)
+}
+
+// settleDust converts aggregated balance of all dust accounts at once and either sends it to dust destination address or burns it
+func settleDust(ctx sdk.Context, app *App, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+	if manifest.Dust == nil || manifest.Dust.AggregatedSourceBalance.Empty() {
+		return nil
+	}
+
+	destDenom := app.StakingKeeper.BondDenom(ctx)
+	sourceBalance := manifest.Dust.AggregatedSourceBalance
+	dustAmount, err := convertBalance(destDenom, sourceBalance, cudosCfg)
+	if err != nil {
+		return err
+	}
+	manifest.Dust.AggregatedAmount = dustAmount
+
+	switch getDustPolicy(cudosCfg) {
+	case DustPolicyAggregate:
+		dustRawAddr, err := sdk.GetFromBech32(cudosCfg.Config.DustDestAddr, sdk.GetConfig().GetBech32AccountAddrPrefix())
+		if err != nil {
+			return fmt.Errorf("failed to get dust destination raw address: %w", err)
+		}
+
+		return migrateToAccount(ctx, app, "dust_accounts", dustRawAddr, sourceBalance, dustAmount, "dust_aggregate", manifest)
+
+	case DustPolicyBurn:
+		err = registerLedgerEntry(manifest, UpgradeLedgerEntry{
+			Domain:       LedgerDomainDestinationBank,
+			Debit:        minttypes.ModuleName,
+			Credit:       LedgerExternalAccount,
+			Amount:       dustAmount,
+			Origin:       "dust_accounts",
+			SourceAmount: sourceBalance,
+			Memo:         "dust_burn",
+		})
+		if err != nil {
+			return err
+		}
+
+		if dustAmount.IsZero() {
+			return nil
+		}
+
+		// Burner account must not hold anything else, so only dust is burned
+		burnerAddr := app.AccountKeeper.GetModuleAddress(dustBurnerModuleName)
+		if balance := app.BankKeeper.GetAllBalances(ctx, burnerAddr); !balance.IsZero() {
+			return fmt.Errorf("dust burner module account %s holds unexpected balance %s", burnerAddr.String(), balance.String())
+		}
+
+		err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, dustBurnerModuleName, dustAmount)
+		if err != nil {
+			return err
+		}
+		err = app.BankKeeper.BurnCoins(ctx, dustBurnerModuleName, dustAmount)
+		if err != nil {
+			return fmt.Errorf("failed to burn dust: %w", err)
+		}
+
+		if balance := app.BankKeeper.GetAllBalances(ctx, burnerAddr); !balance.IsZero() {
+			return fmt.Errorf("dust burner module account %s holds balance %s after dust was burned", burnerAddr.String(), balance.String())
+		}
+
+		manifest.Dust.BurnedAmount = dustAmount
+		return nil
+
+	default:
+		return fmt.Errorf("unknown dust policy \"%s\"", getDustPolicy(cudosCfg))
+	}
+}
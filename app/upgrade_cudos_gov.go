@@ -0,0 +1,154 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cast"
+)
+
+type GovDepositInfo struct {
+	Depositor string
+	Amount    sdk.Coins
+}
+
+type GovProposalInfo struct {
+	ProposalID uint64
+	Status     string
+	Deposits   []*GovDepositInfo
+}
+
+type GovInfo struct {
+	ModuleAccountAddress string
+	Proposals            *OrderedMap[uint64, *GovProposalInfo] // proposal_id -> proposal with its deposits
+}
+
+func parseGenesisGov(jsonData map[string]interface{}, genesisAccounts *OrderedMap[string, *AccountInfo]) (*GovInfo, error) {
+	gov, ok := jsonData[govtypes.ModuleName].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	govModuleAccountAddress, err := GetAddressByName(genesisAccounts, GovAccName)
+	if err != nil {
+		return nil, err
+	}
+
+	govInfo := &GovInfo{
+		ModuleAccountAddress: govModuleAccountAddress,
+		Proposals:            NewOrderedMap[uint64, *GovProposalInfo](),
+	}
+
+	if proposals, ok := gov["proposals"].([]interface{}); ok {
+		for _, proposal := range proposals {
+			proposalMap := proposal.(map[string]interface{})
+			proposalID := cast.ToUint64(proposalMap["proposal_id"])
+			govInfo.Proposals.Set(proposalID, &GovProposalInfo{
+				ProposalID: proposalID,
+				Status:     cast.ToString(proposalMap["status"]),
+			})
+		}
+	}
+
+	if deposits, ok := gov["deposits"].([]interface{}); ok {
+		for _, deposit := range deposits {
+			depositMap := deposit.(map[string]interface{})
+			proposalID := cast.ToUint64(depositMap["proposal_id"])
+
+			amount, err := getCoinsFromInterfaceSlice(depositMap["amount"].([]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse deposit of proposal %d: %w", proposalID, err)
+			}
+
+			// Deposit of proposal missing in genesis is still refunded, status stays unknown
+			proposal, _ := govInfo.Proposals.GetOrSetDefault(proposalID, &GovProposalInfo{ProposalID: proposalID})
+			proposal.Deposits = append(proposal.Deposits, &GovDepositInfo{
+				Depositor: depositMap["depositor"].(string),
+				Amount:    amount,
+			})
+		}
+	}
+
+	return govInfo, nil
+}
+
+// resolveDepositRefundRecipients returns accounts the deposit is refunded to, balances of contracts and module accounts
+// are already handled at this point, so their deposits are routed the same way as their balances
+func resolveDepositRefundRecipients(genesisData *GenesisData, cudosCfg *CudosMergeConfig, depositor string) ([]Pair[string, sdk.Dec], string, error) {
+	if account, exists := genesisData.Accounts.Get(depositor); exists && account.AccountType == ModuleAccountType {
+		return []Pair[string, sdk.Dec]{{Key: cudosCfg.Config.GenericModuleRemainingBalance, Value: sdk.OneDec()}}, "", nil
+	}
+
+	return resolveContractRecipients(depositor, genesisData.Contracts, cudosCfg)
+}
+
+// withdrawGenesisGovDeposits refunds deposits of source chain proposals from gov module account to the depositors
+func withdrawGenesisGovDeposits(genesisData *GenesisData, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+	if genesisData.GovInfo == nil {
+		return nil
+	}
+
+	govAccount := genesisData.Accounts.MustGet(genesisData.GovInfo.ModuleAccountAddress)
+
+	totalDeposits := sdk.NewCoins()
+	for i := range genesisData.GovInfo.Proposals.Iterate() {
+		for _, deposit := range i.Value.Deposits {
+			totalDeposits = totalDeposits.Add(deposit.Amount...)
+		}
+	}
+	if _, isNegative := govAccount.Balance.SafeSub(totalDeposits); isNegative {
+		return fmt.Errorf("gov module balance %s is smaller than total deposits %s", govAccount.Balance.String(), totalDeposits.String())
+	}
+
+	for i := range genesisData.GovInfo.Proposals.Iterate() {
+		proposal := i.Value
+		if len(proposal.Deposits) == 0 {
+			continue
+		}
+
+		proposalRefunds := UpgradeGovProposalRefunds{
+			ProposalID:    proposal.ProposalID,
+			Status:        proposal.Status,
+			TotalRefunded: sdk.NewCoins(),
+		}
+
+		for _, deposit := range proposal.Deposits {
+			recipients, routeDecision, err := resolveDepositRefundRecipients(genesisData, cudosCfg, deposit.Depositor)
+			if err != nil {
+				return err
+			}
+
+			memo := fmt.Sprintf("gov_deposit_refund_%d", proposal.ProposalID)
+			refundParts, err := moveGenesisBalanceToRecipients(genesisData, genesisData.GovInfo.ModuleAccountAddress, recipients, deposit.Amount, memo, manifest, cudosCfg)
+			if err != nil {
+				return fmt.Errorf("failed to refund deposit of %s to proposal %d: %w", deposit.Depositor, proposal.ProposalID, err)
+			}
+
+			if routeDecision != "" {
+				registerContractRouting(manifest, deposit.Depositor, routeDecision, recipients, refundParts, contractRoutedGovDeposit)
+			}
+
+			// Deposit of split contract is recorded as one refund per beneficiary
+			for k, refundPart := range refundParts {
+				if refundPart.IsZero() {
+					continue
+				}
+				proposalRefunds.Refunds = append(proposalRefunds.Refunds, UpgradeGovDepositRefund{
+					Depositor:  deposit.Depositor,
+					RefundedTo: recipients[k].Key,
+					Amount:     refundPart,
+				})
+			}
+			proposalRefunds.TotalRefunded = proposalRefunds.TotalRefunded.Add(deposit.Amount...)
+		}
+
+		if manifest.GovDeposits == nil {
+			manifest.GovDeposits = &UpgradeGovDeposits{}
+		}
+		manifest.GovDeposits.Proposals = append(manifest.GovDeposits.Proposals, proposalRefunds)
+		manifest.GovDeposits.NumberOfRefunds += len(proposalRefunds.Refunds)
+		manifest.GovDeposits.AggregatedRefundedAmount = manifest.GovDeposits.AggregatedRefundedAmount.Add(proposalRefunds.TotalRefunded...)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const DelegationPolicyLiquidBalance = "policy_liquid_balance"
+
+// PolicyDelegation stakes converted liquid balance of the not delegated source account on destination validators split by weight
+type PolicyDelegation struct {
+	Account    string                  `json:"account"`    // Cudos address, must be listed in not delegated accounts
+	Validators []Pair[string, sdk.Dec] `json:"validators"` // Destination validator operator address -> weight
+}
+
+func verifyPolicyDelegations(cudosCfg *CudosMergeConfig, sourceAddrPrefix string, destAddrPrefix string) error {
+	expectedDestValoperPrefix := destAddrPrefix + ValAddressPrefix
+	accounts := NewOrderedMap[string, bool]()
+
+	for _, policyDelegation := range cudosCfg.Config.PolicyDelegations {
+		if err := verifyAddress(policyDelegation.Account, &sourceAddrPrefix); err != nil {
+			return fmt.Errorf("policy delegation: %w", err)
+		}
+		if !cudosCfg.NotDelegatedAccounts.Has(policyDelegation.Account) {
+			return fmt.Errorf("policy delegation: account %s is not listed in not delegated accounts", policyDelegation.Account)
+		}
+		if accounts.Has(policyDelegation.Account) {
+			return fmt.Errorf("policy delegation: account %s is listed more than once", policyDelegation.Account)
+		}
+		accounts.Set(policyDelegation.Account, true)
+
+		if len(policyDelegation.Validators) == 0 {
+			return fmt.Errorf("policy delegation of account %s: list of validators is empty", policyDelegation.Account)
+		}
+		validators := NewOrderedMap[string, bool]()
+		for _, validator := range policyDelegation.Validators {
+			if err := verifyAddress(validator.Key, &expectedDestValoperPrefix); err != nil {
+				return fmt.Errorf("policy delegation of account %s: %w", policyDelegation.Account, err)
+			}
+			if validators.Has(validator.Key) {
+				return fmt.Errorf("policy delegation of account %s: validator %s is listed more than once", policyDelegation.Account, validator.Key)
+			}
+			validators.Set(validator.Key, true)
+			if !validator.Value.IsPositive() {
+				return fmt.Errorf("policy delegation of account %s: weight %s of validator %s is not positive", policyDelegation.Account, validator.Value, validator.Key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitByWeight splits amount proportionally to the weights, truncation remainder goes to the last part
+func splitByWeight(amount sdk.Int, weights []Pair[string, sdk.Dec]) []sdk.Int {
+	totalWeight := sdk.ZeroDec()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight.Value)
+	}
+
+	parts := make([]sdk.Int, len(weights))
+	remaining := amount
+	for i, weight := range weights {
+		if i == len(weights)-1 {
+			parts[i] = remaining
+			break
+		}
+		parts[i] = amount.ToDec().Mul(weight.Value).Quo(totalWeight).TruncateInt()
+		remaining = remaining.Sub(parts[i])
+	}
+
+	return parts
+}
+
+func resolvePolicyValidator(ctx sdk.Context, app *App, operatorAddress string, cudosCfg *CudosMergeConfig) (*stakingtypes.Validator, error) {
+	targetOperatorAddress, err := sdk.ValAddressFromBech32(operatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if targetValidator, found := app.StakingKeeper.GetValidator(ctx, targetOperatorAddress); found {
+		if canReceiveDelegations(&targetValidator) {
+			return &targetValidator, nil
+		}
+	}
+
+	return resolveBackupValidator(ctx, app, cudosCfg)
+}
+
+// getPolicyDelegationAmount returns converted liquid balance of the account which is delegated by policy
+func getPolicyDelegationAmount(genesisData *GenesisData, cudosCfg *CudosMergeConfig, address string, balance sdk.Coins, destDenom string) (sdk.Int, error) {
+	account, exists := genesisData.Accounts.Get(address)
+	if !exists {
+		return sdk.ZeroInt(), fmt.Errorf("policy delegation account %s does not exist", address)
+	}
+	if !isConvertedAccountType(account.AccountType) {
+		return sdk.ZeroInt(), fmt.Errorf("policy delegation account %s is %s account", address, account.AccountType)
+	}
+
+	convertedBalance, _, err := resolveAccountConversion(genesisData, cudosCfg, address, account, balance, destDenom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return convertedBalance.AmountOf(destDenom), nil
+}
+
+// createPolicyDelegations delegates converted liquid balance of accounts configured in policy delegations
+func createPolicyDelegations(ctx sdk.Context, app *App, genesisData *GenesisData, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+	destDenom := app.StakingKeeper.BondDenom(ctx)
+
+	for _, policyDelegation := range cudosCfg.Config.PolicyDelegations {
+		account, exists := genesisData.Accounts.Get(policyDelegation.Account)
+		if !exists {
+			return fmt.Errorf("policy delegation account %s does not exist", policyDelegation.Account)
+		}
+
+		tokensToDelegate, err := getPolicyDelegationAmount(genesisData, cudosCfg, policyDelegation.Account, account.Balance, destDenom)
+		if err != nil {
+			return err
+		}
+
+		var delegatorRawAddr []byte
+		if remappedDelegatorAddr, exists := genesisData.CollisionMap.Get(policyDelegation.Account); exists {
+			_, delegatorRawAddr, err = bech32.DecodeAndConvert(remappedDelegatorAddr)
+		} else {
+			delegatorRawAddr, err = ensureCudosconvertAddressToRaw(policyDelegation.Account, genesisData)
+		}
+		if err != nil {
+			return err
+		}
+
+		originalTokens := splitByWeight(account.Balance.AmountOf(genesisData.BondDenom), policyDelegation.Validators)
+		for i, tokens := range splitByWeight(tokensToDelegate, policyDelegation.Validators) {
+			if !tokens.IsPositive() {
+				continue
+			}
+
+			validator, err := resolvePolicyValidator(ctx, app, policyDelegation.Validators[i].Key, cudosCfg)
+			if err != nil {
+				return fmt.Errorf("policy delegation of account %s: %w", policyDelegation.Account, err)
+			}
+
+			err = createDelegationWithPolicy(ctx, app, "", delegatorRawAddr, *validator, originalTokens[i], tokens, DelegationPolicyLiquidBalance, manifest)
+			if err != nil {
+				return fmt.Errorf("policy delegation of account %s: %w", policyDelegation.Account, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"sort"
+)
+
+// resolveAccountPubKey decodes pubkey of the source account, pubkey which can not be decoded or does not belong to
+// the account address is dropped and the reason is returned instead, account then sets pubkey with its first transaction
+func resolveAccountPubKey(pubKeyMap map[string]interface{}, rawAddress []byte) (cryptotypes.PubKey, string, string) {
+	keyType, _ := pubKeyMap["@type"].(string)
+
+	pubKey, err := decodePubKeyFromMap(pubKeyMap)
+	if err != nil {
+		return nil, keyType, err.Error()
+	}
+
+	if !bytes.Equal(pubKey.Address(), rawAddress) {
+		return nil, keyType, fmt.Sprintf("pubkey address %X does not match account address", pubKey.Address().Bytes())
+	}
+
+	if hasEd25519PubKey(pubKey) {
+		return nil, keyType, "ed25519 keys can not sign transactions of base accounts"
+	}
+
+	return pubKey, keyType, ""
+}
+
+// hasEd25519PubKey checks the key including keys nested in multisig, signature verification rejects ed25519 keys
+// of accounts, so setting such key would not make the account usable
+func hasEd25519PubKey(pubKey cryptotypes.PubKey) bool {
+	switch key := pubKey.(type) {
+	case *ed25519.PubKey:
+		return true
+	case *multisig.LegacyAminoPubKey:
+		for _, subKey := range key.GetPubKeys() {
+			if hasEd25519PubKey(subKey) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func registerAccountPubKey(manifest *UpgradeManifest, accountInfo *AccountInfo) {
+	if accountInfo.PubkeyType == "" {
+		return
+	}
+
+	if manifest.Pubkeys == nil {
+		manifest.Pubkeys = &UpgradePubkeys{}
+	}
+
+	if accountInfo.PubkeyIssue != "" {
+		manifest.Pubkeys.Unsupported = append(manifest.Pubkeys.Unsupported, UpgradeUnsupportedPubkey{
+			Address: accountInfo.Address,
+			Type:    accountInfo.PubkeyType,
+			Reason:  accountInfo.PubkeyIssue,
+		})
+		manifest.Pubkeys.NumberOfUnsupported = len(manifest.Pubkeys.Unsupported)
+		return
+	}
+
+	for i := range manifest.Pubkeys.Decoded {
+		if manifest.Pubkeys.Decoded[i].Type == accountInfo.PubkeyType {
+			manifest.Pubkeys.Decoded[i].NumberOfAccounts++
+			return
+		}
+	}
+	manifest.Pubkeys.Decoded = append(manifest.Pubkeys.Decoded, UpgradeDecodedPubkeys{
+		Type:             accountInfo.PubkeyType,
+		NumberOfAccounts: 1,
+	})
+}
+
+// getAccountsInMigrationOrder returns source accounts ordered by their source account numbers, so that new destination
+// account numbers keep the source order, accounts created during the processing follow in order of creation
+func getAccountsInMigrationOrder(accounts *OrderedMap[string, *AccountInfo]) []string {
+	addresses := accounts.Keys()
+
+	sort.SliceStable(addresses, func(a, b int) bool {
+		accountNumberA := accounts.MustGet(addresses[a]).AccountNumber
+		accountNumberB := accounts.MustGet(addresses[b]).AccountNumber
+		if accountNumberA == nil || accountNumberB == nil {
+			return accountNumberA != nil && accountNumberB == nil
+		}
+		return *accountNumberA < *accountNumberB
+	})
+
+	return addresses
+}
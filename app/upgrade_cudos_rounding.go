@@ -0,0 +1,201 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"sort"
+)
+
+const (
+	RoundingRemainderPolicyPool             = "pool"
+	RoundingRemainderPolicyLargestRemainder = "largest_remainder"
+)
+
+// Memos of ledger entries which migrate balances of user accounts, only these accounts are rounded up
+var userMigrationMemos = []string{"regular_account", "vesting_collision_account"}
+
+type roundingRemainder struct {
+	ledgerEntry int
+	address     string
+	memo        string
+	remainder   sdk.Dec
+}
+
+func getRoundingRemainderPolicy(cudosCfg *CudosMergeConfig) string {
+	if cudosCfg.Config.RoundingRemainderPolicy == "" {
+		return RoundingRemainderPolicyPool
+	}
+	return cudosCfg.Config.RoundingRemainderPolicy
+}
+
+// collectRoundingRemainders returns truncation remainders of all conversions minted so far, in order of ledger entries
+func collectRoundingRemainders(ledger *UpgradeLedger, denom string) ([]roundingRemainder, sdk.Dec) {
+	var remainders []roundingRemainder
+	total := sdk.ZeroDec()
+
+	for _, entry := range ledger.Entries {
+		// Remainders of retired amounts, e.g. burned dust, have no account to be settled to
+		if entry.Domain != LedgerDomainDestinationBank || isIssuanceAccount(entry.Credit) {
+			continue
+		}
+
+		remainder := entry.RoundingRemainder.AmountOf(denom)
+		if !remainder.IsPositive() {
+			continue
+		}
+
+		remainders = append(remainders, roundingRemainder{
+			ledgerEntry: entry.Index,
+			address:     entry.Credit,
+			memo:        entry.Memo,
+			remainder:   remainder,
+		})
+		total = total.Add(remainder)
+	}
+
+	return remainders, total
+}
+
+// getUserMigrationRemainders filters remainders of user account migrations, remainders of intermediate and module
+// accounts are not eligible for round up. Vesting accounts are not eligible either, the round up would not be part of
+// their original vesting amount and so it would be spendable immediately.
+func getUserMigrationRemainders(ctx sdk.Context, app *App, remainders []roundingRemainder) ([]roundingRemainder, sdk.Dec, int, error) {
+	var res []roundingRemainder
+	total := sdk.ZeroDec()
+	numberOfVesting := 0
+
+	for _, remainder := range remainders {
+		for _, memo := range userMigrationMemos {
+			if remainder.memo != memo {
+				continue
+			}
+
+			rawAddr, err := sdk.AccAddressFromBech32(remainder.address)
+			if err != nil {
+				return nil, sdk.ZeroDec(), 0, err
+			}
+			if _, isVesting := app.AccountKeeper.GetAccount(ctx, rawAddr).(vestexported.VestingAccount); isVesting {
+				numberOfVesting++
+				break
+			}
+
+			res = append(res, remainder)
+			total = total.Add(remainder.remainder)
+			break
+		}
+	}
+
+	return res, total, numberOfVesting, nil
+}
+
+// allocateLargestRemainders distributes whole units of the aggregated remainder to conversions with the largest fractional remainders
+func allocateLargestRemainders(remainders []roundingRemainder, unitsToDistribute sdk.Int) []sdk.Int {
+	allocations := make([]sdk.Int, len(remainders))
+	fractions := make([]sdk.Dec, len(remainders))
+	order := make([]int, len(remainders))
+
+	allocated := sdk.ZeroInt()
+	for i, remainder := range remainders {
+		allocations[i] = remainder.remainder.TruncateInt()
+		fractions[i] = remainder.remainder.Sub(allocations[i].ToDec())
+		allocated = allocated.Add(allocations[i])
+		order[i] = i
+	}
+
+	// Ties are resolved by ledger order, so result is deterministic
+	sort.SliceStable(order, func(a, b int) bool {
+		return fractions[order[a]].GT(fractions[order[b]])
+	})
+
+	extraUnits := unitsToDistribute.Sub(allocated)
+	for i := 0; extraUnits.IsPositive() && i < len(order); i++ {
+		allocations[order[i]] = allocations[order[i]].AddRaw(1)
+		extraUnits = extraUnits.SubRaw(1)
+	}
+
+	return allocations
+}
+
+func settleRoundingRemainders(ctx sdk.Context, app *App, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) error {
+	if manifest.Ledger == nil {
+		return nil
+	}
+
+	denom := app.StakingKeeper.BondDenom(ctx)
+	policy := getRoundingRemainderPolicy(cudosCfg)
+
+	remainders, totalRemainder := collectRoundingRemainders(manifest.Ledger, denom)
+	unitsToDistribute := totalRemainder.TruncateInt()
+
+	manifest.RoundingRemainders = &UpgradeRoundingRemainders{
+		Policy:              policy,
+		NumberOfRemainders:  len(remainders),
+		AggregatedRemainder: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, totalRemainder)),
+		DistributedAmount:   sdk.NewCoins(),
+	}
+
+	switch policy {
+	case RoundingRemainderPolicyPool:
+		// Without destination address the pooled remainder stays in mint module and is handled as its remaining balance
+		if cudosCfg.Config.RoundingRemainderDestAddr != "" && unitsToDistribute.IsPositive() {
+			poolRawAddr, err := sdk.GetFromBech32(cudosCfg.Config.RoundingRemainderDestAddr, sdk.GetConfig().GetBech32AccountAddrPrefix())
+			if err != nil {
+				return fmt.Errorf("failed to get rounding remainder destination raw address: %w", err)
+			}
+
+			pooledAmount := sdk.NewCoins(sdk.NewCoin(denom, unitsToDistribute))
+			err = migrateToAccount(ctx, app, "rounding_remainder", poolRawAddr, sdk.NewCoins(), pooledAmount, "rounding_remainder_pool", manifest)
+			if err != nil {
+				return err
+			}
+
+			manifest.RoundingRemainders.PoolAddress = cudosCfg.Config.RoundingRemainderDestAddr
+			manifest.RoundingRemainders.DistributedAmount = pooledAmount
+		}
+
+	case RoundingRemainderPolicyLargestRemainder:
+		// Remainders of other conversions stay in mint module as residual remainder
+		userRemainders, totalUserRemainder, numberOfVesting, err := getUserMigrationRemainders(ctx, app, remainders)
+		if err != nil {
+			return err
+		}
+		manifest.RoundingRemainders.NumberOfExcludedVesting = numberOfVesting
+		allocations := allocateLargestRemainders(userRemainders, totalUserRemainder.TruncateInt())
+
+		for i, remainder := range userRemainders {
+			if !allocations[i].IsPositive() {
+				continue
+			}
+
+			rawAddr, err := sdk.AccAddressFromBech32(remainder.address)
+			if err != nil {
+				return err
+			}
+
+			// Destination is base account, so round up is spendable as the rest of its balance
+			amount := sdk.NewCoins(sdk.NewCoin(denom, allocations[i]))
+			err = migrateToAccount(ctx, app, "rounding_remainder", rawAddr, sdk.NewCoins(), amount, "rounding_remainder_round_up", manifest)
+			if err != nil {
+				return err
+			}
+
+			manifest.RoundingRemainders.Adjustments = append(manifest.RoundingRemainders.Adjustments, UpgradeRoundingAdjustment{
+				Address:     remainder.address,
+				LedgerEntry: remainder.ledgerEntry,
+				Remainder:   remainder.remainder,
+				Amount:      amount,
+			})
+			manifest.RoundingRemainders.DistributedAmount = manifest.RoundingRemainders.DistributedAmount.Add(amount...)
+		}
+		manifest.RoundingRemainders.NumberOfAdjustments = len(manifest.RoundingRemainders.Adjustments)
+
+	default:
+		return fmt.Errorf("unknown rounding remainder policy \"%s\"", policy)
+	}
+
+	residualRemainder := totalRemainder.Sub(manifest.RoundingRemainders.DistributedAmount.AmountOf(denom).ToDec())
+	manifest.RoundingRemainders.ResidualRemainder = sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, residualRemainder))
+
+	return nil
+}
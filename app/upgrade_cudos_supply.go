@@ -0,0 +1,337 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"strings"
+)
+
+const (
+	SupplyLineAccounts    = "accounts"
+	SupplyLineContracts   = "contracts"
+	SupplyLineModulePools = "module_pools"
+	SupplyLineEscrow      = "escrow"
+	SupplyLineRewards     = "rewards"
+	SupplyLineTotal       = "total"
+
+	SupplyLineMinted               = "minted"
+	SupplyLineCommission           = "commission"
+	SupplyLineMoved                = "moved"
+	SupplyLineExtraSupply          = "extra_supply"
+	SupplyLineCommunityPool        = "community_pool"
+	SupplyLineRoundingRemainder    = "rounding_remainder"
+	SupplyLineDust                 = "dust"
+	SupplyLineRemainingMintBalance = "remaining_mint_balance"
+	SupplyLineTotalDistributed     = "total_distributed"
+	SupplyLineDelegated            = "delegated"
+)
+
+// Lines of destination breakdown which together account for the whole minted supply
+var distributedSupplyLines = []string{
+	SupplyLineCommission,
+	SupplyLineMoved,
+	SupplyLineExtraSupply,
+	SupplyLineCommunityPool,
+	SupplyLineRoundingRemainder,
+	SupplyLineDust,
+	SupplyLineRemainingMintBalance,
+}
+
+func newSupplyBreakdownLine(name string, denom string, expected *sdk.Int, actual *sdk.Int) *UpgradeSupplyBreakdownLine {
+	line := &UpgradeSupplyBreakdownLine{
+		Name:  name,
+		Denom: denom,
+	}
+	line.setValues(expected, actual)
+	return line
+}
+
+func (line *UpgradeSupplyBreakdownLine) setValues(expected *sdk.Int, actual *sdk.Int) {
+	line.Expected = expected
+	line.Actual = actual
+	line.Difference = nil
+
+	if expected != nil && actual != nil {
+		difference := actual.Sub(*expected)
+		line.Difference = &difference
+	}
+}
+
+func getSourceSupplyLine(genesisData *GenesisData, address string, account *AccountInfo) string {
+	if genesisData.DistributionInfo != nil && address == genesisData.DistributionInfo.DistributionModuleAccountAddress {
+		return SupplyLineRewards
+	}
+
+	switch account.AccountType {
+	case ModuleAccountType:
+		return SupplyLineModulePools
+	case ContractAccountType:
+		return SupplyLineContracts
+	case IBCAccountType:
+		return SupplyLineEscrow
+	default:
+		return SupplyLineAccounts
+	}
+}
+
+// writeSourceSupplyBreakdownToManifest splits source total supply of all converted denominations by holder category
+func writeSourceSupplyBreakdownToManifest(genesisData *GenesisData, cudosCfg *CudosMergeConfig, manifest *UpgradeManifest) {
+	sourceLines := NewOrderedMap[string, sdk.Coins]()
+	for _, line := range []string{SupplyLineAccounts, SupplyLineContracts, SupplyLineModulePools, SupplyLineEscrow, SupplyLineRewards} {
+		sourceLines.Set(line, sdk.NewCoins())
+	}
+
+	for i := range genesisData.Accounts.Iterate() {
+		address, account := i.Key, i.Value
+		line := getSourceSupplyLine(genesisData, address, account)
+		sourceLines.Set(line, sourceLines.MustGet(line).Add(account.Balance...))
+	}
+
+	breakdown := &UpgradeSupplyBreakdown{}
+	for _, denom := range cudosCfg.BalanceConversionConstants.Keys() {
+		total := sdk.ZeroInt()
+		for i := range sourceLines.Iterate() {
+			line, balance := i.Key, i.Value
+			amount := balance.AmountOf(denom)
+			total = total.Add(amount)
+			breakdown.Source = append(breakdown.Source, newSupplyBreakdownLine(line, denom, nil, &amount))
+		}
+
+		expectedTotal := genesisData.TotalSupply.AmountOf(denom)
+		breakdown.Source = append(breakdown.Source, newSupplyBreakdownLine(SupplyLineTotal, denom, &expectedTotal, &total))
+	}
+
+	manifest.SupplyBreakdown = breakdown
+}
+
+func convertToAmount(destDenom string, balance sdk.Coins, cudosCfg *CudosMergeConfig) (*sdk.Int, error) {
+	converted, err := convertBalance(destDenom, balance, cudosCfg)
+	if err != nil {
+		return nil, err
+	}
+	amount := converted.AmountOf(destDenom)
+	return &amount, nil
+}
+
+// WriteExpectedDestinationSupplyToManifest calculates expected destination supply breakdown from processed source genesis data
+func WriteExpectedDestinationSupplyToManifest(genesisData *GenesisData, cudosCfg *CudosMergeConfig, destDenom string, manifest *UpgradeManifest) error {
+	if manifest.SupplyBreakdown == nil {
+		manifest.SupplyBreakdown = &UpgradeSupplyBreakdown{}
+	}
+
+	// All remaining balances are held by accounts after processing of the source genesis
+	remainingBalance := sdk.NewCoins()
+	for i := range genesisData.Accounts.Iterate() {
+		remainingBalance = remainingBalance.Add(i.Value.Balance...)
+	}
+
+	communityPoolBalance := sdk.NewCoins()
+	if cudosCfg.Config.CommunityPoolBalanceDestAddr == "" && genesisData.DistributionInfo != nil {
+		communityPoolBalance, _ = genesisData.DistributionInfo.FeePool.CommunityPool.TruncateDecimal()
+	}
+
+	extraSupplyCudosAddress, err := ConvertAddressPrefix(cudosCfg.Config.ExtraSupplyFetchAddr, genesisData.Prefix)
+	if err != nil {
+		return err
+	}
+	extraSupplyBalance := sdk.NewCoins(sdk.NewCoin(genesisData.BondDenom, cudosCfg.Config.TotalCudosSupply.Sub(genesisData.TotalSupply.AmountOf(genesisData.BondDenom))))
+	extraSupplyAccountBalance := sdk.NewCoins()
+	if extraSupplyAccount, exists := genesisData.Accounts.Get(extraSupplyCudosAddress); exists {
+		extraSupplyAccountBalance = extraSupplyAccount.Balance
+	}
+
+	// Account balances as they will be at the time of migration, after community pool balance is handled
+	balanceOverrides, err := getBalancesAfterCommunityPool(genesisData, cudosCfg)
+	if err != nil {
+		return err
+	}
+	conversionTiers, err := conversionTierAdjustment(genesisData, cudosCfg, destDenom, balanceOverrides)
+	if err != nil {
+		return err
+	}
+
+	// Delegations are converted the same way as balance of the delegator
+	delegated := sdk.ZeroInt()
+	for i := range genesisData.Delegations.Iterate() {
+		delegatorAddr, delegations := i.Key, i.Value
+		if cudosCfg.NotDelegatedAccounts.Has(delegatorAddr) {
+			continue
+		}
+
+		var delegatorBalance sdk.Coins
+		if delegatorAccount, exists := genesisData.Accounts.Get(delegatorAddr); exists {
+			delegatorBalance = delegatorAccount.Balance
+		}
+		if overriddenBalance, exists := balanceOverrides.Get(delegatorAddr); exists {
+			delegatorBalance = overriddenBalance
+		}
+		conversion, err := getAccountConversion(genesisData, cudosCfg, delegatorAddr, delegatorBalance, destDenom)
+		if err != nil {
+			return err
+		}
+
+		for j := range delegations.Iterate() {
+			if _, validatorPolicy := getSourceValidatorPolicy(genesisData, cudosCfg, j.Key); validatorPolicy == ValidatorPolicyLiquid {
+				continue
+			}
+			delegated = delegated.Add(conversion.convertAmount(sdk.NewCoin(genesisData.BondDenom, j.Value)))
+		}
+	}
+
+	// Liquid balances delegated by policy
+	for _, policyDelegation := range cudosCfg.Config.PolicyDelegations {
+		var balance sdk.Coins
+		if account, exists := genesisData.Accounts.Get(policyDelegation.Account); exists {
+			balance = account.Balance
+		}
+		if overriddenBalance, exists := balanceOverrides.Get(policyDelegation.Account); exists {
+			balance = overriddenBalance
+		}
+		amount, err := getPolicyDelegationAmount(genesisData, cudosCfg, policyDelegation.Account, balance, destDenom)
+		if err != nil {
+			return err
+		}
+		delegated = delegated.Add(amount)
+	}
+
+	minted := cudosCfg.Config.TotalFetchSupplyToMint
+	convertedTotalSupply, err := convertToAmount(destDenom, sdk.NewCoins(sdk.NewCoin(genesisData.BondDenom, cudosCfg.Config.TotalCudosSupply)), cudosCfg)
+	if err != nil {
+		return err
+	}
+	commission := minted.Sub(*convertedTotalSupply).Sub(conversionTiers.Adjustment)
+
+	communityPool, err := convertToAmount(destDenom, communityPoolBalance, cudosCfg)
+	if err != nil {
+		return err
+	}
+	extraSupply, err := convertToAmount(destDenom, extraSupplyAccountBalance.Add(extraSupplyBalance...), cudosCfg)
+	if err != nil {
+		return err
+	}
+	dustBalance, err := getDustSourceBalance(genesisData, cudosCfg, destDenom, balanceOverrides)
+	if err != nil {
+		return err
+	}
+	dust, err := convertToAmount(destDenom, dustBalance, cudosCfg)
+	if err != nil {
+		return err
+	}
+	movedBalance, isNegative := remainingBalance.SafeSub(communityPoolBalance.Add(extraSupplyAccountBalance...).Add(dustBalance...))
+	if isNegative {
+		return fmt.Errorf("remaining balance %s is smaller than community pool %s, extra supply account balance %s and dust %s", remainingBalance.String(), communityPoolBalance.String(), extraSupplyAccountBalance.String(), dustBalance.String())
+	}
+	moved, err := convertToAmount(destDenom, movedBalance, cudosCfg)
+	if err != nil {
+		return err
+	}
+	*moved = moved.Add(conversionTiers.Adjustment)
+
+	manifest.SupplyBreakdown.Destination = []*UpgradeSupplyBreakdownLine{
+		newSupplyBreakdownLine(SupplyLineMinted, destDenom, &minted, nil),
+		newSupplyBreakdownLine(SupplyLineCommission, destDenom, &commission, nil),
+		newSupplyBreakdownLine(SupplyLineMoved, destDenom, moved, nil),
+		newSupplyBreakdownLine(SupplyLineExtraSupply, destDenom, extraSupply, nil),
+		newSupplyBreakdownLine(SupplyLineCommunityPool, destDenom, communityPool, nil),
+		newSupplyBreakdownLine(SupplyLineRoundingRemainder, destDenom, nil, nil),
+		newSupplyBreakdownLine(SupplyLineDust, destDenom, dust, nil),
+		newSupplyBreakdownLine(SupplyLineRemainingMintBalance, destDenom, nil, nil),
+		newSupplyBreakdownLine(SupplyLineTotalDistributed, destDenom, &minted, nil),
+		// Delegated tokens are taken from moved balances, so this line is not part of the total
+		newSupplyBreakdownLine(SupplyLineDelegated, destDenom, &delegated, nil),
+	}
+
+	return nil
+}
+
+// getBalancesAfterCommunityPool returns balances of accounts affected by handling of the community pool balance
+func getBalancesAfterCommunityPool(genesisData *GenesisData, cudosCfg *CudosMergeConfig) (*OrderedMap[string, sdk.Coins], error) {
+	balances := NewOrderedMap[string, sdk.Coins]()
+	if genesisData.DistributionInfo == nil {
+		return balances, nil
+	}
+
+	communityPoolBalance, _ := genesisData.DistributionInfo.FeePool.CommunityPool.TruncateDecimal()
+	if remainingDistributionAccount, exists := genesisData.Accounts.Get(cudosCfg.Config.RemainingDistributionBalanceAddr); exists {
+		remainingBalance, isNegative := remainingDistributionAccount.Balance.SafeSub(communityPoolBalance)
+		if isNegative {
+			return nil, fmt.Errorf("remaining distribution balance %s is smaller than community pool %s", remainingDistributionAccount.Balance.String(), communityPoolBalance.String())
+		}
+		balances.Set(cudosCfg.Config.RemainingDistributionBalanceAddr, remainingBalance)
+	}
+
+	if destAddr := cudosCfg.Config.CommunityPoolBalanceDestAddr; destAddr != "" {
+		destBalance, exists := balances.Get(destAddr)
+		if !exists {
+			if destAccount, exists := genesisData.Accounts.Get(destAddr); exists {
+				destBalance = destAccount.Balance
+			}
+		}
+		balances.Set(destAddr, destBalance.Add(communityPoolBalance...))
+	}
+
+	return balances, nil
+}
+
+func getDestinationSupplyLine(entry *UpgradeLedgerEntry, cudosCfg *CudosMergeConfig) string {
+	switch {
+	case entry.Memo == "total_commission":
+		return SupplyLineCommission
+	case entry.Memo == "community_pool_balance":
+		return SupplyLineCommunityPool
+	case entry.Memo == "remaining_mint_module_balance":
+		return SupplyLineRemainingMintBalance
+	case strings.HasPrefix(entry.Memo, "rounding_remainder"):
+		return SupplyLineRoundingRemainder
+	case strings.HasPrefix(entry.Memo, "dust_"):
+		return SupplyLineDust
+	case entry.Credit == cudosCfg.Config.ExtraSupplyFetchAddr:
+		return SupplyLineExtraSupply
+	default:
+		return SupplyLineMoved
+	}
+}
+
+// fillDestinationSupplyActuals fills actual values of destination breakdown from the ledger
+func fillDestinationSupplyActuals(manifest *UpgradeManifest, cudosCfg *CudosMergeConfig, destDenom string) error {
+	if manifest.SupplyBreakdown == nil || manifest.Ledger == nil {
+		return fmt.Errorf("supply breakdown or ledger is missing in manifest")
+	}
+
+	actuals := NewOrderedMap[string, sdk.Int]()
+	for _, line := range manifest.SupplyBreakdown.Destination {
+		actuals.Set(line.Name, sdk.ZeroInt())
+	}
+	addActual := func(line string, amount sdk.Int) {
+		actual, _ := actuals.GetOrSetDefault(line, sdk.ZeroInt())
+		actuals.Set(line, actual.Add(amount))
+	}
+
+	for i := range manifest.Ledger.Entries {
+		entry := &manifest.Ledger.Entries[i]
+		amount := entry.Amount.AmountOf(destDenom)
+
+		switch {
+		case entry.Domain == LedgerDomainDestinationBank && entry.Debit == LedgerExternalAccount && entry.Credit == minttypes.ModuleName:
+			addActual(SupplyLineMinted, amount)
+		case entry.Domain == LedgerDomainDestinationBank && entry.Debit == minttypes.ModuleName:
+			addActual(getDestinationSupplyLine(entry, cudosCfg), amount)
+		case entry.Domain == LedgerDomainDestinationStaking:
+			addActual(SupplyLineDelegated, amount)
+		}
+	}
+
+	totalDistributed := sdk.ZeroInt()
+	for _, line := range distributedSupplyLines {
+		totalDistributed = totalDistributed.Add(actuals.MustGet(line))
+	}
+	actuals.Set(SupplyLineTotalDistributed, totalDistributed)
+
+	for _, line := range manifest.SupplyBreakdown.Destination {
+		actual := actuals.MustGet(line.Name)
+		line.setValues(line.Expected, &actual)
+	}
+
+	return nil
+}
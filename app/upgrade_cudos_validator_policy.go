@@ -0,0 +1,142 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	SourceValidatorStateJailed     = "jailed"
+	SourceValidatorStateTombstoned = "tombstoned"
+
+	// ValidatorPolicyRedelegate moves delegations via validators map like delegations to any other validator
+	ValidatorPolicyRedelegate = "redelegate"
+	// ValidatorPolicyLiquid does not recreate delegations, converted stake stays liquid in delegator account
+	ValidatorPolicyLiquid = "liquid"
+	// ValidatorPolicyBackup delegates to backup validators regardless of validators map
+	ValidatorPolicyBackup = "backup"
+)
+
+type ValidatorSigningInfo struct {
+	StartHeight         int64
+	IndexOffset         int64
+	JailedUntil         string
+	Tombstoned          bool
+	MissedBlocksCounter int64
+}
+
+func isKnownValidatorPolicy(policy string) bool {
+	switch policy {
+	case ValidatorPolicyRedelegate, ValidatorPolicyLiquid, ValidatorPolicyBackup:
+		return true
+	}
+	return false
+}
+
+func verifyValidatorPolicies(cudosCfg *CudosMergeConfig) error {
+	if policy := cudosCfg.Config.JailedValidatorPolicy; policy != "" && !isKnownValidatorPolicy(policy) {
+		return fmt.Errorf("unknown jailed validator policy \"%s\"", policy)
+	}
+	if policy := cudosCfg.Config.TombstonedValidatorPolicy; policy != "" && !isKnownValidatorPolicy(policy) {
+		return fmt.Errorf("unknown tombstoned validator policy \"%s\"", policy)
+	}
+	return nil
+}
+
+func getJailedValidatorPolicy(cudosCfg *CudosMergeConfig) string {
+	if cudosCfg.Config.JailedValidatorPolicy == "" {
+		return ValidatorPolicyRedelegate
+	}
+	return cudosCfg.Config.JailedValidatorPolicy
+}
+
+// getTombstonedValidatorPolicy defaults to jailed validator policy, since tombstoned validators are always jailed
+func getTombstonedValidatorPolicy(cudosCfg *CudosMergeConfig) string {
+	if cudosCfg.Config.TombstonedValidatorPolicy == "" {
+		return getJailedValidatorPolicy(cudosCfg)
+	}
+	return cudosCfg.Config.TombstonedValidatorPolicy
+}
+
+// getSourceValidatorPolicy returns state of the source validator and policy applied to delegations to it,
+// state is empty for validators in good standing
+func getSourceValidatorPolicy(genesisData *GenesisData, cudosCfg *CudosMergeConfig, operatorAddress string) (string, string) {
+	validator, exists := genesisData.Validators.Get(operatorAddress)
+	if !exists {
+		return "", ValidatorPolicyRedelegate
+	}
+
+	if validator.SigningInfo != nil && validator.SigningInfo.Tombstoned {
+		return SourceValidatorStateTombstoned, getTombstonedValidatorPolicy(cudosCfg)
+	}
+	if validator.Jailed {
+		return SourceValidatorStateJailed, getJailedValidatorPolicy(cudosCfg)
+	}
+
+	return "", ValidatorPolicyRedelegate
+}
+
+// parseGenesisSigningInfos assigns slashing signing info to validators by their consensus address
+func parseGenesisSigningInfos(jsonData map[string]interface{}, validators *OrderedMap[string, *ValidatorInfo]) error {
+	slashing, ok := jsonData[slashingtypes.ModuleName].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	signingInfos, ok := slashing["signing_infos"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	// Consensus address hex -> validator
+	validatorsByConsAddress := NewOrderedMap[string, *ValidatorInfo]()
+	for i := range validators.Iterate() {
+		validator := i.Value
+		if validator.ConsensusPubkey != nil {
+			validatorsByConsAddress.Set(hex.EncodeToString(validator.ConsensusPubkey.Address()), validator)
+		}
+	}
+
+	for _, signingInfo := range signingInfos {
+		signingInfoMap := signingInfo.(map[string]interface{})
+		_, consAddress, err := bech32.DecodeAndConvert(signingInfoMap["address"].(string))
+		if err != nil {
+			return fmt.Errorf("failed to decode signing info address: %w", err)
+		}
+
+		validator, exists := validatorsByConsAddress.Get(hex.EncodeToString(consAddress))
+		if !exists {
+			continue
+		}
+
+		info := signingInfoMap["validator_signing_info"].(map[string]interface{})
+		validator.SigningInfo = &ValidatorSigningInfo{
+			StartHeight:         cast.ToInt64(info["start_height"]),
+			IndexOffset:         cast.ToInt64(info["index_offset"]),
+			JailedUntil:         cast.ToString(info["jailed_until"]),
+			Tombstoned:          cast.ToBool(info["tombstoned"]),
+			MissedBlocksCounter: cast.ToInt64(info["missed_blocks_counter"]),
+		}
+	}
+
+	return nil
+}
+
+func registerLiquidatedDelegation(manifest *UpgradeManifest, delegatorAddress string, validatorAddress string, validatorState string, originalTokens sdk.Int, tokens sdk.Int) {
+	if manifest.Delegate == nil {
+		manifest.Delegate = &UpgradeDelegate{}
+	}
+
+	manifest.Delegate.LiquidatedDelegations = append(manifest.Delegate.LiquidatedDelegations, UpgradeLiquidatedDelegation{
+		Delegator:            delegatorAddress,
+		OriginalValidator:    validatorAddress,
+		SourceValidatorState: validatorState,
+		ValidatorPolicy:      ValidatorPolicyLiquid,
+		OriginalTokens:       originalTokens,
+		Tokens:               tokens,
+	})
+	manifest.Delegate.NumberOfLiquidatedDelegations = len(manifest.Delegate.LiquidatedDelegations)
+}
@@ -0,0 +1,191 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	VestingTypeNone       = "none"
+	VestingTypeContinuous = "continuous"
+	VestingTypePeriodic   = "periodic"
+
+	// Vesting after the cliff is approximated by monthly periods unless the schedule sets its own step
+	defaultVestingStepAfterCliff = 30 * 24 * 60 * 60
+	minVestingStepAfterCliff     = 24 * 60 * 60
+)
+
+// VestingSchedule configures vesting of the listed accounts, accounts which are not listed in any schedule vest
+// continuously from the upgrade block time over the vesting period
+type VestingSchedule struct {
+	Name                       string   `json:"name"`
+	Accounts                   []string `json:"accounts"`                                // Cudos addresses
+	StartTime                  *int64   `json:"start_time,omitempty"`                    // Absolute unix time, upgrade block time if not set
+	Cliff                      int64    `json:"cliff,omitempty"`                         // Seconds from start time before anything vests
+	StepAfterCliff             int64    `json:"step_after_cliff,omitempty"`              // Seconds between vesting periods after the cliff, 30 days if not set
+	UseRemainingSourceSchedule bool     `json:"use_remaining_source_schedule,omitempty"` // Vesting ends with the source vesting schedule, capped at vesting period
+}
+
+func verifyVestingSchedules(cudosCfg *CudosMergeConfig, sourceAddrPrefix string) error {
+	scheduleAccounts := NewOrderedMap[string, string]()
+
+	for i, schedule := range cudosCfg.Config.VestingSchedules {
+		if schedule.Name == "" {
+			return fmt.Errorf("vesting schedule %d: name is not set", i)
+		}
+		if schedule.Cliff < 0 {
+			return fmt.Errorf("vesting schedule %s: negative cliff %d", schedule.Name, schedule.Cliff)
+		}
+		if schedule.StepAfterCliff != 0 && schedule.StepAfterCliff < minVestingStepAfterCliff {
+			return fmt.Errorf("vesting schedule %s: step after cliff %d is shorter than %d seconds", schedule.Name, schedule.StepAfterCliff, minVestingStepAfterCliff)
+		}
+		if schedule.StartTime != nil && *schedule.StartTime <= 0 {
+			return fmt.Errorf("vesting schedule %s: start time %d is not positive", schedule.Name, *schedule.StartTime)
+		}
+
+		for _, account := range schedule.Accounts {
+			if err := verifyAddress(account, &sourceAddrPrefix); err != nil {
+				return fmt.Errorf("vesting schedule %s: %w", schedule.Name, err)
+			}
+			if cudosCfg.NotVestedAccounts.Has(account) {
+				return fmt.Errorf("vesting schedule %s: account %s is listed in not vested accounts", schedule.Name, account)
+			}
+			if otherSchedule, exists := scheduleAccounts.Get(account); exists {
+				return fmt.Errorf("vesting schedule %s: account %s is already listed in schedule %s", schedule.Name, account, otherSchedule)
+			}
+			scheduleAccounts.Set(account, schedule.Name)
+		}
+	}
+
+	return nil
+}
+
+// canMigrateWithRemainingSchedule returns true if source vesting account is migrated as vesting account with remaining
+// source schedule, instead of being handled as vesting collision
+func canMigrateWithRemainingSchedule(cudosCfg *CudosMergeConfig, genesisAccount *AccountInfo) bool {
+	switch genesisAccount.AccountType {
+	case DelayedVestingAccountType, ContinuousVestingAccountType, PeriodicVestingAccountType:
+	default:
+		return false
+	}
+
+	schedule, exists := cudosCfg.VestingSchedules.Get(genesisAccount.Address)
+	return exists && schedule.UseRemainingSourceSchedule
+}
+
+// resolveVestingSchedule calculates vesting of the migrated account at the given block time
+func resolveVestingSchedule(blockTime int64, cudosCfg *CudosMergeConfig, genesisAccount *AccountInfo, vestedCoins sdk.Coins) *UpgradeVestingSchedule {
+	res := &UpgradeVestingSchedule{
+		Address:         genesisAccount.Address,
+		Type:            VestingTypeNone,
+		SourceEndTime:   genesisAccount.EndTime,
+		OriginalVesting: vestedCoins,
+	}
+
+	// Contract escrow holds funds of the contract, which are released as a whole
+	if cudosCfg.NotVestedAccounts.Has(genesisAccount.Address) || cudosCfg.EscrowAddresses.Has(genesisAccount.Address) {
+		return res
+	}
+
+	res.StartTime = blockTime
+	schedule, hasSchedule := cudosCfg.VestingSchedules.Get(genesisAccount.Address)
+	if hasSchedule {
+		res.Schedule = schedule.Name
+		if schedule.StartTime != nil {
+			res.StartTime = *schedule.StartTime
+		}
+	}
+
+	res.EndTime = res.StartTime + cudosCfg.Config.VestingPeriod
+	if hasSchedule && schedule.UseRemainingSourceSchedule && genesisAccount.EndTime != 0 && genesisAccount.EndTime < res.EndTime {
+		res.EndTime = genesisAccount.EndTime
+	}
+
+	// Schedule which already ended, e.g. source schedule ended before the upgrade, leaves the account without vesting
+	if res.EndTime <= blockTime || res.EndTime <= res.StartTime {
+		return res
+	}
+
+	res.Type = VestingTypeContinuous
+	if hasSchedule && schedule.Cliff > 0 {
+		res.CliffTime = res.StartTime + schedule.Cliff
+		if res.CliffTime > res.EndTime {
+			res.CliffTime = res.EndTime
+		}
+		res.Type = VestingTypePeriodic
+
+		res.StepAfterCliff = defaultVestingStepAfterCliff
+		if schedule.StepAfterCliff != 0 {
+			res.StepAfterCliff = schedule.StepAfterCliff
+		}
+	}
+
+	return res
+}
+
+// cliffVestingPeriods splits linear vesting from start to end time into periods, first period releases everything
+// vested until the cliff and following periods are steps of the given length
+func cliffVestingPeriods(vestedCoins sdk.Coins, startTime int64, cliffTime int64, endTime int64, step int64) authvesting.Periods {
+	duration := sdk.NewInt(endTime - startTime)
+
+	var periods authvesting.Periods
+	vestedSoFar := sdk.NewCoins()
+	previousTime := startTime
+	for periodEnd := cliffTime; ; periodEnd += step {
+		if periodEnd > endTime {
+			periodEnd = endTime
+		}
+
+		vested := sdk.NewCoins()
+		for _, coin := range vestedCoins {
+			vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewInt(periodEnd-startTime)).Quo(duration)))
+		}
+
+		periods = append(periods, authvesting.Period{
+			Length: periodEnd - previousTime,
+			Amount: vested.Sub(vestedSoFar),
+		})
+		vestedSoFar = vested
+		previousTime = periodEnd
+
+		if periodEnd == endTime {
+			break
+		}
+	}
+
+	return periods
+}
+
+func createNewPeriodicVestingAccountFromBaseAccount(ctx sdk.Context, app *App, account *authtypes.BaseAccount, vestedCoins sdk.Coins, startTime int64, cliffTime int64, endTime int64, step int64) error {
+	newBaseVestingAcc := authvesting.NewBaseVestingAccount(account, vestedCoins, endTime)
+	newPeriodicVestingAcc := authvesting.NewPeriodicVestingAccountRaw(newBaseVestingAcc, startTime, cliffVestingPeriods(vestedCoins, startTime, cliffTime, endTime, step))
+
+	app.AccountKeeper.SetAccount(ctx, newPeriodicVestingAcc)
+
+	return nil
+}
+
+// createMigratedAccount creates destination account according to the vesting schedule
+func createMigratedAccount(ctx sdk.Context, app *App, account *authtypes.BaseAccount, schedule *UpgradeVestingSchedule) error {
+	switch schedule.Type {
+	case VestingTypeNone:
+		return createNewNormalAccountFromBaseAccount(ctx, app, account)
+	case VestingTypeContinuous:
+		return createNewVestingAccountFromBaseAccount(ctx, app, account, schedule.OriginalVesting, schedule.StartTime, schedule.EndTime)
+	case VestingTypePeriodic:
+		return createNewPeriodicVestingAccountFromBaseAccount(ctx, app, account, schedule.OriginalVesting, schedule.StartTime, schedule.CliffTime, schedule.EndTime, schedule.StepAfterCliff)
+	default:
+		return fmt.Errorf("unknown vesting type \"%s\"", schedule.Type)
+	}
+}
+
+func registerVestingSchedule(manifest *UpgradeManifest, schedule *UpgradeVestingSchedule) {
+	if manifest.VestingSchedules == nil {
+		manifest.VestingSchedules = &UpgradeVestingSchedules{}
+	}
+
+	manifest.VestingSchedules.Schedules = append(manifest.VestingSchedules.Schedules, *schedule)
+	manifest.VestingSchedules.NumberOfSchedules = len(manifest.VestingSchedules.Schedules)
+}
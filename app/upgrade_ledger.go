@@ -0,0 +1,460 @@
+package app
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"strings"
+)
+
+type LedgerDomain string
+
+const (
+	LedgerDomainSourceBank         LedgerDomain = "source_bank"
+	LedgerDomainSourceDelegations  LedgerDomain = "source_delegations"
+	LedgerDomainDestinationBank    LedgerDomain = "destination_bank"
+	LedgerDomainDestinationStaking LedgerDomain = "destination_staking"
+
+	// LedgerExternalAccount is counterparty of entries which create or remove balance outside of regular movements
+	LedgerExternalAccount = "external"
+)
+
+type UpgradeLedgerEntry struct {
+	Index             int                     `json:"index"`
+	Domain            LedgerDomain            `json:"domain"`
+	Debit             string                  `json:"debit"`
+	Credit            string                  `json:"credit"`
+	Amount            sdk.Coins               `json:"amount"`
+	Validator         string                  `json:"validator,omitempty"`
+	Origin            string                  `json:"origin,omitempty"`
+	SourceAmount      sdk.Coins               `json:"source_amount,omitempty"`
+	UnconvertedAmount sdk.Coins               `json:"unconverted_amount,omitempty"`
+	ConversionRates   []Pair[string, sdk.Dec] `json:"conversion_rates,omitempty"`
+	ConversionCap     *sdk.Int                `json:"conversion_cap,omitempty"`
+	CappedAmount      sdk.DecCoins            `json:"capped_amount,omitempty"`
+	RoundingRemainder sdk.DecCoins            `json:"rounding_remainder,omitempty"`
+	Memo              string                  `json:"memo,omitempty"`
+}
+
+type UpgradeLedgerDomainTotals struct {
+	Domain          LedgerDomain `json:"domain"`
+	NumberOfEntries int          `json:"number_of_entries"`
+	TotalAmount     sdk.Coins    `json:"total_amount"`
+	Issued          sdk.Coins    `json:"issued,omitempty"`
+	Retired         sdk.Coins    `json:"retired,omitempty"`
+}
+
+type UpgradeLedger struct {
+	Entries         []UpgradeLedgerEntry         `json:"entries"`
+	NumberOfEntries int                          `json:"number_of_entries"`
+	Totals          []*UpgradeLedgerDomainTotals `json:"totals"`
+
+	TotalConvertedSourceAmount sdk.Coins    `json:"total_converted_source_amount,omitempty"`
+	TotalConvertedDestAmount   sdk.Coins    `json:"total_converted_dest_amount,omitempty"`
+	TotalRoundingRemainder     sdk.DecCoins `json:"total_rounding_remainder,omitempty"`
+	TotalCappedAmount          sdk.DecCoins `json:"total_capped_amount,omitempty"`
+
+	conversionRates  *OrderedMap[string, sdk.Dec]
+	destinationDenom string
+	balanceOf        func(account string) (sdk.Coins, error)
+	openingBalances  *OrderedMap[string, sdk.Coins]
+}
+
+func NewUpgradeLedger() *UpgradeLedger {
+	return &UpgradeLedger{}
+}
+
+func getLedger(manifest *UpgradeManifest) *UpgradeLedger {
+	if manifest.Ledger == nil {
+		manifest.Ledger = NewUpgradeLedger()
+	}
+	return manifest.Ledger
+}
+
+// SetConversion configures conversion rates and destination denomination used to validate conversion entries
+func (l *UpgradeLedger) SetConversion(conversionRates *OrderedMap[string, sdk.Dec], destinationDenom string) {
+	l.conversionRates = conversionRates
+	l.destinationDenom = destinationDenom
+}
+
+// SetBalanceSource enables tracking of destination bank balances, opening balance of the account is recorded when the
+// account appears in the ledger for the first time, so entries must be registered before the balance is moved
+func (l *UpgradeLedger) SetBalanceSource(balanceOf func(account string) (sdk.Coins, error)) {
+	l.balanceOf = balanceOf
+	l.openingBalances = NewOrderedMap[string, sdk.Coins]()
+}
+
+// getBankAccounts returns accounts of the entry whose destination bank balance is changed by the entry
+func getBankAccounts(entry *UpgradeLedgerEntry) []string {
+	var accounts []string
+	switch entry.Domain {
+	case LedgerDomainDestinationBank:
+		accounts = []string{entry.Debit, entry.Credit}
+	case LedgerDomainDestinationStaking:
+		// Credit account is validator operator, delegated tokens are moved to staking pool module account
+		accounts = []string{entry.Debit}
+	}
+
+	var res []string
+	for _, account := range accounts {
+		if !isIssuanceAccount(account) {
+			res = append(res, account)
+		}
+	}
+	return res
+}
+
+func (l *UpgradeLedger) recordOpeningBalances(accounts []string) error {
+	if l.balanceOf == nil {
+		return nil
+	}
+
+	for _, account := range accounts {
+		if l.openingBalances.Has(account) {
+			continue
+		}
+		balance, err := l.balanceOf(account)
+		if err != nil {
+			return fmt.Errorf("failed to get opening balance of ledger account %s: %w", account, err)
+		}
+		l.openingBalances.Set(account, balance)
+	}
+
+	return nil
+}
+
+// TrackBalances records opening balances of accounts whose balance is about to be changed by other modules, e.g. by
+// distribution hooks, so the movement can be registered once its amount is known
+func (l *UpgradeLedger) TrackBalances(accounts ...string) error {
+	return l.recordOpeningBalances(accounts)
+}
+
+func (l *UpgradeLedger) getDomainTotals(domain LedgerDomain) *UpgradeLedgerDomainTotals {
+	for _, totals := range l.Totals {
+		if totals.Domain == domain {
+			return totals
+		}
+	}
+
+	totals := &UpgradeLedgerDomainTotals{Domain: domain}
+	l.Totals = append(l.Totals, totals)
+	return totals
+}
+
+func isIssuanceAccount(account string) bool {
+	return account == LedgerExternalAccount
+}
+
+func checkLedgerEntry(entry *UpgradeLedgerEntry) error {
+	if entry.Domain == "" {
+		return fmt.Errorf("ledger entry domain is not set")
+	}
+	if entry.Debit == "" || entry.Credit == "" {
+		return fmt.Errorf("ledger entry must have both debit and credit account, got debit \"%s\" and credit \"%s\"", entry.Debit, entry.Credit)
+	}
+	if entry.Debit == entry.Credit {
+		return fmt.Errorf("ledger entry debit and credit account is the same: %s", entry.Debit)
+	}
+	if !entry.Amount.IsValid() && !entry.Amount.Empty() {
+		return fmt.Errorf("ledger entry amount %s is not valid", entry.Amount.String())
+	}
+	if !entry.SourceAmount.IsValid() && !entry.SourceAmount.Empty() {
+		return fmt.Errorf("ledger entry source amount %s is not valid", entry.SourceAmount.String())
+	}
+	return nil
+}
+
+// fillConversion calculates conversion details of the entry and verifies that destination amount is consistent with source amount
+func (l *UpgradeLedger) fillConversion(entry *UpgradeLedgerEntry) error {
+	if l.conversionRates == nil {
+		return fmt.Errorf("ledger conversion rates are not configured")
+	}
+
+	if len(entry.Amount) > 1 || (len(entry.Amount) == 1 && entry.Amount[0].Denom != l.destinationDenom) {
+		return fmt.Errorf("converted amount %s must be in %s denomination only", entry.Amount.String(), l.destinationDenom)
+	}
+
+	rates := l.conversionRates
+	if entry.ConversionRates != nil {
+		rates = NewOrderedMapFromPairs(entry.ConversionRates)
+	}
+	entry.ConversionRates = nil
+
+	exactAmount := sdk.ZeroDec()
+	numberOfConvertedCoins := 0
+	for _, coin := range entry.SourceAmount {
+		if conversionConstant, exists := rates.Get(coin.Denom); exists {
+			exactAmount = exactAmount.Add(coin.Amount.ToDec().Quo(conversionConstant))
+			entry.ConversionRates = append(entry.ConversionRates, Pair[string, sdk.Dec]{Key: coin.Denom, Value: conversionConstant})
+			numberOfConvertedCoins++
+		} else {
+			entry.UnconvertedAmount = entry.UnconvertedAmount.Add(coin)
+		}
+	}
+
+	// Amount exceeding the cap is not converted at all
+	if entry.ConversionCap != nil && exactAmount.GT(entry.ConversionCap.ToDec()) {
+		entry.CappedAmount = sdk.NewDecCoins(sdk.NewDecCoinFromDec(l.destinationDenom, exactAmount.Sub(entry.ConversionCap.ToDec())))
+		exactAmount = entry.ConversionCap.ToDec()
+	}
+
+	remainder := exactAmount.Sub(entry.Amount.AmountOf(l.destinationDenom).ToDec())
+	if remainder.IsNegative() || remainder.GTE(sdk.NewDec(int64(numberOfConvertedCoins))) {
+		return fmt.Errorf("converted amount %s is inconsistent with source amount %s, rounding remainder %s is out of bounds", entry.Amount.String(), entry.SourceAmount.String(), remainder.String())
+	}
+
+	if remainder.IsPositive() {
+		entry.RoundingRemainder = sdk.NewDecCoins(sdk.NewDecCoinFromDec(l.destinationDenom, remainder))
+	}
+
+	return nil
+}
+
+// AddEntry checks invariants of the entry and records it to the ledger
+func (l *UpgradeLedger) AddEntry(entry UpgradeLedgerEntry) error {
+	if err := checkLedgerEntry(&entry); err != nil {
+		return err
+	}
+
+	isConversion := !entry.SourceAmount.Empty()
+	if isConversion {
+		if err := l.fillConversion(&entry); err != nil {
+			return fmt.Errorf("ledger entry from %s to %s: %w", entry.Debit, entry.Credit, err)
+		}
+	}
+
+	if err := l.recordOpeningBalances(getBankAccounts(&entry)); err != nil {
+		return err
+	}
+
+	entry.Index = len(l.Entries)
+	l.Entries = append(l.Entries, entry)
+	l.NumberOfEntries = len(l.Entries)
+
+	totals := l.getDomainTotals(entry.Domain)
+	totals.NumberOfEntries++
+	totals.TotalAmount = totals.TotalAmount.Add(entry.Amount...)
+	if isIssuanceAccount(entry.Debit) {
+		totals.Issued = totals.Issued.Add(entry.Amount...)
+	}
+	if isIssuanceAccount(entry.Credit) {
+		totals.Retired = totals.Retired.Add(entry.Amount...)
+	}
+
+	if isConversion {
+		l.TotalConvertedSourceAmount = l.TotalConvertedSourceAmount.Add(entry.SourceAmount.Sub(entry.UnconvertedAmount)...)
+		l.TotalConvertedDestAmount = l.TotalConvertedDestAmount.Add(entry.Amount...)
+		l.TotalRoundingRemainder = l.TotalRoundingRemainder.Add(entry.RoundingRemainder...)
+		l.TotalCappedAmount = l.TotalCappedAmount.Add(entry.CappedAmount...)
+	}
+
+	return nil
+}
+
+// Verify recalculates all aggregated values from the entries and checks them against the running totals
+func (l *UpgradeLedger) Verify() error {
+	if l.NumberOfEntries != len(l.Entries) {
+		return fmt.Errorf("ledger: number of entries %d does not match length of entries %d", l.NumberOfEntries, len(l.Entries))
+	}
+
+	domainTotals := NewOrderedMap[LedgerDomain, sdk.Coins]()
+	domainIssued := NewOrderedMap[LedgerDomain, sdk.Coins]()
+	domainRetired := NewOrderedMap[LedgerDomain, sdk.Coins]()
+	domainCredits := NewOrderedMap[LedgerDomain, sdk.Coins]()
+	domainDebits := NewOrderedMap[LedgerDomain, sdk.Coins]()
+	mintIssued := sdk.NewCoins()
+	mintDebited := sdk.NewCoins()
+	convertedDestAmount := sdk.NewCoins()
+	roundingRemainder := sdk.NewDecCoins()
+	cappedAmount := sdk.NewDecCoins()
+
+	for i, entry := range l.Entries {
+		if entry.Index != i {
+			return fmt.Errorf("ledger: entry index %d does not match its position %d", entry.Index, i)
+		}
+		if err := checkLedgerEntry(&entry); err != nil {
+			return fmt.Errorf("ledger: entry %d: %w", i, err)
+		}
+
+		total, _ := domainTotals.GetOrSetDefault(entry.Domain, sdk.NewCoins())
+		domainTotals.Set(entry.Domain, total.Add(entry.Amount...))
+
+		// Internal accounts are all accounts except external one, debit from external account is issuance and credit to it retirement
+		if isIssuanceAccount(entry.Debit) {
+			issued, _ := domainIssued.GetOrSetDefault(entry.Domain, sdk.NewCoins())
+			domainIssued.Set(entry.Domain, issued.Add(entry.Amount...))
+		} else {
+			debits, _ := domainDebits.GetOrSetDefault(entry.Domain, sdk.NewCoins())
+			domainDebits.Set(entry.Domain, debits.Add(entry.Amount...))
+		}
+		if isIssuanceAccount(entry.Credit) {
+			retired, _ := domainRetired.GetOrSetDefault(entry.Domain, sdk.NewCoins())
+			domainRetired.Set(entry.Domain, retired.Add(entry.Amount...))
+		} else {
+			credits, _ := domainCredits.GetOrSetDefault(entry.Domain, sdk.NewCoins())
+			domainCredits.Set(entry.Domain, credits.Add(entry.Amount...))
+		}
+
+		// Mint account can not spend more than was issued to it up to this entry
+		if entry.Domain == LedgerDomainDestinationBank {
+			if entry.Credit == minttypes.ModuleName && isIssuanceAccount(entry.Debit) {
+				mintIssued = mintIssued.Add(entry.Amount...)
+			}
+			if entry.Debit == minttypes.ModuleName {
+				mintDebited = mintDebited.Add(entry.Amount...)
+				if !mintIssued.IsAllGTE(mintDebited) {
+					return fmt.Errorf("ledger: entry %d: amount %s debited from %s is not covered by issued amount %s", i, mintDebited.String(), minttypes.ModuleName, mintIssued.String())
+				}
+			}
+		}
+
+		if !entry.SourceAmount.Empty() {
+			convertedDestAmount = convertedDestAmount.Add(entry.Amount...)
+			roundingRemainder = roundingRemainder.Add(entry.RoundingRemainder...)
+			cappedAmount = cappedAmount.Add(entry.CappedAmount...)
+		}
+	}
+
+	for _, totals := range l.Totals {
+		expectedTotal, _ := domainTotals.Get(totals.Domain)
+		if !isEqualCoins(totals.TotalAmount, expectedTotal) {
+			return fmt.Errorf("ledger: total amount %s of domain %s does not match sum of entries %s", totals.TotalAmount.String(), totals.Domain, expectedTotal.String())
+		}
+
+		issued, _ := domainIssued.Get(totals.Domain)
+		retired, _ := domainRetired.Get(totals.Domain)
+		if !isEqualCoins(totals.Issued, issued) || !isEqualCoins(totals.Retired, retired) {
+			return fmt.Errorf("ledger: issued %s and retired %s amounts of domain %s do not match sums of entries %s and %s", totals.Issued.String(), totals.Retired.String(), totals.Domain, issued.String(), retired.String())
+		}
+
+		// Balance held by internal accounts must equal to what was issued to them and not retired
+		credits, _ := domainCredits.Get(totals.Domain)
+		debits, _ := domainDebits.Get(totals.Domain)
+		internalNet, isNegative := credits.SafeSub(debits)
+		if isNegative {
+			return fmt.Errorf("ledger: internal accounts of domain %s were debited %s, which is more than credited %s", totals.Domain, debits.String(), credits.String())
+		}
+		outstanding, isNegative := issued.SafeSub(retired)
+		if isNegative || !isEqualCoins(internalNet, outstanding) {
+			return fmt.Errorf("ledger: net balance %s of internal accounts of domain %s does not match issued %s minus retired %s", internalNet.String(), totals.Domain, issued.String(), retired.String())
+		}
+	}
+
+	if !isEqualCoins(l.TotalConvertedDestAmount, convertedDestAmount) {
+		return fmt.Errorf("ledger: total converted amount %s does not match sum of entries %s", l.TotalConvertedDestAmount.String(), convertedDestAmount.String())
+	}
+
+	if !isEqualDecCoins(l.TotalRoundingRemainder, roundingRemainder) {
+		return fmt.Errorf("ledger: total rounding remainder %s does not match sum of entries %s", l.TotalRoundingRemainder.String(), roundingRemainder.String())
+	}
+
+	if !isEqualDecCoins(l.TotalCappedAmount, cappedAmount) {
+		return fmt.Errorf("ledger: total capped amount %s does not match sum of entries %s", l.TotalCappedAmount.String(), cappedAmount.String())
+	}
+
+	return nil
+}
+
+// VerifyBalances checks that current destination bank balance of every account recorded in the ledger equals to its
+// opening balance plus credits minus debits of all its entries
+func (l *UpgradeLedger) VerifyBalances() error {
+	if l.balanceOf == nil {
+		return fmt.Errorf("ledger: balance source is not configured")
+	}
+
+	expectedBalances := NewOrderedMap[string, sdk.Coins]()
+	expectedDebits := NewOrderedMap[string, sdk.Coins]()
+	for i := range l.openingBalances.Iterate() {
+		expectedBalances.Set(i.Key, i.Value)
+		expectedDebits.Set(i.Key, sdk.NewCoins())
+	}
+
+	for _, entry := range l.Entries {
+		for _, account := range getBankAccounts(&entry) {
+			if !expectedBalances.Has(account) {
+				return fmt.Errorf("ledger: opening balance of account %s is not recorded", account)
+			}
+			if account == entry.Debit {
+				expectedDebits.Set(account, expectedDebits.MustGet(account).Add(entry.Amount...))
+			} else {
+				expectedBalances.Set(account, expectedBalances.MustGet(account).Add(entry.Amount...))
+			}
+		}
+	}
+
+	var mismatched []string
+	for i := range expectedBalances.Iterate() {
+		account, credited := i.Key, i.Value
+		expected, isNegative := credited.SafeSub(expectedDebits.MustGet(account))
+		if isNegative {
+			mismatched = append(mismatched, fmt.Sprintf("%s was debited %s, which is more than its opening balance plus credits %s", account, expectedDebits.MustGet(account).String(), credited.String()))
+			continue
+		}
+
+		balance, err := l.balanceOf(account)
+		if err != nil {
+			return fmt.Errorf("ledger: failed to get balance of account %s: %w", account, err)
+		}
+		if !isEqualCoins(balance, expected) {
+			mismatched = append(mismatched, fmt.Sprintf("%s has balance %s, expected %s", account, balance.String(), expected.String()))
+		}
+	}
+
+	if len(mismatched) > 0 {
+		return fmt.Errorf("ledger: balances of %d accounts do not match the ledger: %s", len(mismatched), strings.Join(mismatched, "; "))
+	}
+	return nil
+}
+
+// IssuedAmount returns total amount issued in the domain by external account
+func (l *UpgradeLedger) IssuedAmount(domain LedgerDomain) sdk.Coins {
+	for _, totals := range l.Totals {
+		if totals.Domain == domain {
+			return totals.Issued
+		}
+	}
+	return sdk.NewCoins()
+}
+
+// RetiredAmount returns total amount retired in the domain to external account
+func (l *UpgradeLedger) RetiredAmount(domain LedgerDomain) sdk.Coins {
+	for _, totals := range l.Totals {
+		if totals.Domain == domain {
+			return totals.Retired
+		}
+	}
+	return sdk.NewCoins()
+}
+
+// DebitedAmount returns total amount debited from the account in the domain
+func (l *UpgradeLedger) DebitedAmount(domain LedgerDomain, account string) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, entry := range l.Entries {
+		if entry.Domain == domain && entry.Debit == account {
+			res = res.Add(entry.Amount...)
+		}
+	}
+	return res
+}
+
+// isEqualCoins compares coins with possibly different denominations, unlike sdk.Coins.IsEqual it does not panic
+func isEqualCoins(coinsA, coinsB sdk.Coins) bool {
+	return coinsA.IsAllGTE(coinsB) && coinsB.IsAllGTE(coinsA)
+}
+
+// isEqualDecCoins is DecCoins counterpart of isEqualCoins, zero amounts are dropped by subtraction
+func isEqualDecCoins(coinsA, coinsB sdk.DecCoins) bool {
+	diff, _ := coinsA.SafeSub(coinsB)
+	return diff.IsZero()
+}
+
+func ledgerAccount(address string) string {
+	if address == "" {
+		return LedgerExternalAccount
+	}
+	return address
+}
+
+func registerLedgerEntry(manifest *UpgradeManifest, entry UpgradeLedgerEntry) error {
+	return getLedger(manifest).AddEntry(entry)
+}
@@ -37,6 +37,18 @@ type UpgradeManifest struct {
 	VestingCollision   *UpgradeVestingCollision   `json:"vesting_collision,omitempty"`
 	MoveDelegations    *UpgradeMoveDelegations    `json:"move_delegation,omitempty"`
 	CreatedAccounts    *UpgradeCreatedAccounts    `json:"created_accounts,omitempty"`
+	SupplyVerification *UpgradeSupplyVerification `json:"supply_verification,omitempty"`
+	ToleranceChecks    []UpgradeToleranceCheck    `json:"tolerance_checks,omitempty"`
+	Ledger             *UpgradeLedger             `json:"ledger,omitempty"`
+	RoundingRemainders *UpgradeRoundingRemainders `json:"rounding_remainders,omitempty"`
+	SupplyBreakdown    *UpgradeSupplyBreakdown    `json:"supply_breakdown,omitempty"`
+	ConversionTiers    *UpgradeConversionTiers    `json:"conversion_tiers,omitempty"`
+	Dust               *UpgradeDust               `json:"dust,omitempty"`
+	VestingSchedules   *UpgradeVestingSchedules   `json:"vesting_schedules,omitempty"`
+	GovDeposits        *UpgradeGovDeposits        `json:"gov_deposits,omitempty"`
+	ContractRouting    *UpgradeContractRouting    `json:"contract_routing,omitempty"`
+	Pubkeys            *UpgradePubkeys            `json:"pubkeys,omitempty"`
+	Audit              *UpgradeAudit              `json:"audit,omitempty"`
 }
 
 func NewUpgradeManifest() *UpgradeManifest {
@@ -49,16 +61,59 @@ type ParamsChange[T any] struct {
 }
 
 type Contracts struct {
-	StateCleaned   []string                `json:"contracts_state_cleaned,omitempty"`
-	AdminUpdated   []ContractValueUpdate   `json:"contracts_admin_updated,omitempty"`
-	LabelUpdated   []ContractValueUpdate   `json:"contracts_label_updated,omitempty"`
-	VersionUpdated []ContractVersionUpdate `json:"version_updated,omitempty"`
+	StateCleaned   []string                     `json:"contracts_state_cleaned,omitempty"`
+	AdminUpdated   []ContractValueUpdate        `json:"contracts_admin_updated,omitempty"`
+	LabelUpdated   []ContractValueUpdate        `json:"contracts_label_updated,omitempty"`
+	VersionUpdated []ContractVersionUpdate      `json:"version_updated,omitempty"`
+	Operations     []ContractOperationRecord    `json:"operations,omitempty"`
+	Migrated       []ContractMigrationRecord    `json:"migrated,omitempty"`
+	StatePruned    []ContractStatePruningRecord `json:"state_pruned,omitempty"`
+}
+
+// ContractStatePrunedRange reports removed keys of one namespace, keys are hex encoded
+type ContractStatePrunedRange struct {
+	Namespace           string `json:"namespace,omitempty"`
+	NumberOfDeletedKeys int    `json:"number_of_deleted_keys"`
+	NumberOfKeptKeys    int    `json:"number_of_kept_keys"`
+	FirstDeletedKey     string `json:"first_deleted_key,omitempty"`
+	LastDeletedKey      string `json:"last_deleted_key,omitempty"`
+}
+
+type ContractStatePruningRecord struct {
+	Contract            string                     `json:"contract"`
+	Predicate           *ContractStatePredicate    `json:"predicate,omitempty"`
+	Namespaces          []ContractStatePrunedRange `json:"namespaces"`
+	NumberOfDeletedKeys int                        `json:"number_of_deleted_keys"`
+}
+
+type ContractMigrationRecord struct {
+	Contract     string          `json:"contract"`
+	FromCodeID   uint64          `json:"from_code_id"`
+	ToCodeID     uint64          `json:"to_code_id"`
+	CodeStored   bool            `json:"code_stored"`             // New code was stored as part of the upgrade
+	CodeChecksum string          `json:"code_checksum,omitempty"` // sha256 of uncompressed wasm code given by path
+	MigrateMsg   json.RawMessage `json:"migrate_msg"`
+	Response     []byte          `json:"response,omitempty"`
+}
+
+// ContractOperationRecord records applied contract operation, admin rotation or migration, raw keys and values are hex
+// encoded, JSON values are stored as is
+type ContractOperationRecord struct {
+	Index               int    `json:"index"`
+	Source              string `json:"source"`
+	Type                string `json:"type"`
+	Contract            string `json:"contract"`
+	Key                 string `json:"key,omitempty"`
+	From                string `json:"from,omitempty"`
+	To                  string `json:"to,omitempty"`
+	NumberOfDeletedKeys int    `json:"number_of_deleted_keys,omitempty"`
 }
 
 type ContractValueUpdate struct {
 	Address string `json:"address"`
 	From    string `json:"from"`
 	To      string `json:"to"`
+	Kind    string `json:"kind,omitempty"` // Distinguishes wasmd admin from owner stored in contract state
 }
 
 type ContractVersionUpdate struct {
@@ -73,8 +128,24 @@ type ValueUpdate struct {
 }
 
 type UpgradeReconciliation struct {
-	Transfers     *UpgradeReconciliationTransfers     `json:"transfers,omitempty"`
-	ContractState *UpgradeReconciliationContractState `json:"contract_state,omitempty"`
+	InputCSVPath            string                              `json:"input_csv_path,omitempty"`
+	InputCSVSha256          string                              `json:"input_csv_sha256,omitempty"`
+	Transfers               *UpgradeReconciliationTransfers     `json:"transfers,omitempty"`
+	ContractState           *UpgradeReconciliationContractState `json:"contract_state,omitempty"`
+	RejectedRecords         []ReconciliationRecordIssue         `json:"rejected_records,omitempty"`
+	NumberOfRejectedRecords int                                 `json:"number_of_rejected_records,omitempty"`
+	Decisions               []UpgradeReconciliationDecision     `json:"decisions,omitempty"`
+	NumberOfDecisions       int                                 `json:"number_of_decisions,omitempty"`
+}
+
+// UpgradeReconciliationDecision records why balance of reconciliation address was not swept or swept only partially
+type UpgradeReconciliationDecision struct {
+	EthAddr  string      `json:"eth_addr"`
+	From     string      `json:"from"`
+	Decision string      `json:"decision"`
+	Reason   string      `json:"reason"`
+	Balance  types.Coins `json:"balance"`
+	Swept    types.Coins `json:"swept,omitempty"`
 }
 
 type UpgradeReconciliationTransfer struct {
@@ -108,11 +179,14 @@ type UpgradeIBCTransfer struct {
 }
 
 type UpgradeBalanceMovement struct {
-	From          string      `json:"from"`
-	To            string      `json:"to"`
-	SourceBalance types.Coins `json:"source_balance,omitempty"`
-	DestBalance   types.Coins `json:"dest_balance,omitempty"`
-	Memo          string      `json:"memo,omitempty"`
+	From            string                    `json:"from"`
+	To              string                    `json:"to"`
+	SourceBalance   types.Coins               `json:"source_balance,omitempty"`
+	DestBalance     types.Coins               `json:"dest_balance,omitempty"`
+	ConversionTier  string                    `json:"conversion_tier,omitempty"`
+	ConversionRates []Pair[string, types.Dec] `json:"conversion_rates,omitempty"`
+	CappedAmount    types.DecCoins            `json:"capped_amount,omitempty"`
+	Memo            string                    `json:"memo,omitempty"`
 }
 
 type UpgradeIBCTransfers struct {
@@ -148,9 +222,24 @@ type UpgradeVestingCollision struct {
 }
 
 type UpgradeDelegate struct {
-	Delegations               []UpgradeDelegation `json:"delegation"`
-	AggregatedDelegatedAmount *types.Int          `json:"aggregated_delegated_amount"`
-	NumberOfDelegations       int                 `json:"number_of_delegations"`
+	Delegations                     []UpgradeDelegation `json:"delegation"`
+	AggregatedDelegatedAmount       *types.Int          `json:"aggregated_delegated_amount"`
+	NumberOfDelegations             int                 `json:"number_of_delegations"`
+	AggregatedPolicyDelegatedAmount *types.Int          `json:"aggregated_policy_delegated_amount,omitempty"`
+	NumberOfPolicyDelegations       int                 `json:"number_of_policy_delegations,omitempty"`
+
+	LiquidatedDelegations         []UpgradeLiquidatedDelegation `json:"liquidated_delegations,omitempty"`
+	NumberOfLiquidatedDelegations int                           `json:"number_of_liquidated_delegations,omitempty"`
+}
+
+// UpgradeLiquidatedDelegation records source delegation which was not recreated, its converted tokens stay liquid
+type UpgradeLiquidatedDelegation struct {
+	Delegator            string    `json:"delegator"`
+	OriginalValidator    string    `json:"original_validator"`
+	SourceValidatorState string    `json:"source_validator_state"`
+	ValidatorPolicy      string    `json:"validator_policy"`
+	OriginalTokens       types.Int `json:"original_tokens"`
+	Tokens               types.Int `json:"tokens"`
 }
 
 type UpgradeMoveDelegations struct {
@@ -165,6 +254,10 @@ type UpgradeDelegation struct {
 	OriginalTokens    types.Int `json:"original_tokens"`
 	NewTokens         types.Int `json:"new_tokens"`
 	NewShares         types.Dec `json:"new_shares"`
+	Policy            string    `json:"policy,omitempty"` // Set for delegations created by config policy instead of recreating source delegation
+
+	SourceValidatorState string `json:"source_validator_state,omitempty"` // Set if source validator was jailed or tombstoned
+	ValidatorPolicy      string `json:"validator_policy,omitempty"`       // Policy applied to delegation to jailed or tombstoned validator
 }
 
 type VestingCollision struct {
@@ -188,6 +281,167 @@ type UpgradeAccountCreation struct {
 	Reason  string `json:"reason"`
 }
 
+type UpgradeSupplyVerification struct {
+	DestinationSupplyBefore types.Coins `json:"destination_supply_before"`
+	DestinationSupplyAfter  types.Coins `json:"destination_supply_after"`
+	ExpectedMintedSupply    types.Coins `json:"expected_minted_supply"`
+	MintedSupply            types.Coins `json:"minted_supply"`
+	MaxToleratedDifference  types.Int   `json:"max_tolerated_difference"`
+}
+
+type UpgradeSupplyBreakdownLine struct {
+	Name       string     `json:"name"`
+	Denom      string     `json:"denom"`
+	Expected   *types.Int `json:"expected,omitempty"`
+	Actual     *types.Int `json:"actual,omitempty"`
+	Difference *types.Int `json:"difference,omitempty"`
+}
+
+type UpgradeSupplyBreakdown struct {
+	Source      []*UpgradeSupplyBreakdownLine `json:"source"`
+	Destination []*UpgradeSupplyBreakdownLine `json:"destination,omitempty"`
+}
+
+type UpgradeConversionTierTotals struct {
+	Name                   string    `json:"name"`
+	NumberOfAccounts       int       `json:"number_of_accounts"`
+	NumberOfCappedAccounts int       `json:"number_of_capped_accounts"`
+	DefaultConvertedAmount types.Int `json:"default_converted_amount"`
+	TierConvertedAmount    types.Int `json:"tier_converted_amount"`
+}
+
+// UpgradeConversionTiers summarises accounts converted with overridden conversion constants, adjustment is deducted from commission
+type UpgradeConversionTiers struct {
+	Tiers                  []*UpgradeConversionTierTotals `json:"tiers"`
+	NumberOfAccounts       int                            `json:"number_of_accounts"`
+	DefaultConvertedAmount types.Int                      `json:"default_converted_amount"`
+	TierConvertedAmount    types.Int                      `json:"tier_converted_amount"`
+	Adjustment             types.Int                      `json:"adjustment"`
+}
+
+type UpgradeDustAccount struct {
+	Address         string      `json:"address"`
+	SourceBalance   types.Coins `json:"source_balance,omitempty"`
+	ConvertedAmount types.Int   `json:"converted_amount"`
+}
+
+// UpgradeDust lists source accounts which were not migrated to destination accounts because of their negligible balance
+type UpgradeDust struct {
+	Threshold               types.Int            `json:"threshold"`
+	Policy                  string               `json:"policy"`
+	DestAddress             string               `json:"dest_address,omitempty"`
+	Accounts                []UpgradeDustAccount `json:"accounts"`
+	NumberOfAccounts        int                  `json:"number_of_accounts"`
+	AggregatedSourceBalance types.Coins          `json:"aggregated_source_balance"`
+	AggregatedAmount        types.Coins          `json:"aggregated_amount"`
+	BurnedAmount            types.Coins          `json:"burned_amount,omitempty"`
+}
+
+// UpgradeVestingSchedule records vesting of the migrated account, times are unix timestamps
+type UpgradeVestingSchedule struct {
+	Address         string      `json:"address"`
+	Schedule        string      `json:"schedule,omitempty"`
+	Type            string      `json:"type"`
+	StartTime       int64       `json:"start_time,omitempty"`
+	CliffTime       int64       `json:"cliff_time,omitempty"`
+	StepAfterCliff  int64       `json:"step_after_cliff,omitempty"` // Length of periods after the cliff of periodic vesting
+	EndTime         int64       `json:"end_time,omitempty"`
+	SourceEndTime   int64       `json:"source_end_time,omitempty"`
+	OriginalVesting types.Coins `json:"original_vesting,omitempty"`
+}
+
+type UpgradeVestingSchedules struct {
+	Schedules         []UpgradeVestingSchedule `json:"schedules"`
+	NumberOfSchedules int                      `json:"number_of_schedules"`
+}
+
+type UpgradeGovDepositRefund struct {
+	Depositor  string      `json:"depositor"`
+	RefundedTo string      `json:"refunded_to,omitempty"`
+	Amount     types.Coins `json:"amount"`
+}
+
+type UpgradeGovProposalRefunds struct {
+	ProposalID    uint64                    `json:"proposal_id"`
+	Status        string                    `json:"status,omitempty"`
+	Refunds       []UpgradeGovDepositRefund `json:"refunds"`
+	TotalRefunded types.Coins               `json:"total_refunded"`
+}
+
+// UpgradeGovDeposits lists deposits of source chain proposals refunded to depositors, amounts are in source denominations
+type UpgradeGovDeposits struct {
+	Proposals                []UpgradeGovProposalRefunds `json:"proposals"`
+	NumberOfRefunds          int                         `json:"number_of_refunds"`
+	AggregatedRefundedAmount types.Coins                 `json:"aggregated_refunded_amount"`
+}
+
+type UpgradeContractRouteRecipient struct {
+	Address    string      `json:"address"`
+	Weight     types.Dec   `json:"weight"`
+	Balance    types.Coins `json:"balance"`
+	Delegation types.Coins `json:"delegation"`
+	GovDeposit types.Coins `json:"gov_deposit"`
+}
+
+// UpgradeContractRoute records routing decision for everything held by the contract, amounts are in source denominations
+type UpgradeContractRoute struct {
+	Contract         string                          `json:"contract"`
+	Route            string                          `json:"route"`
+	Recipients       []UpgradeContractRouteRecipient `json:"recipients"`
+	RoutedBalance    types.Coins                     `json:"routed_balance"`
+	RoutedDelegation types.Coins                     `json:"routed_delegation"`
+	RoutedGovDeposit types.Coins                     `json:"routed_gov_deposit"`
+}
+
+type UpgradeContractRouting struct {
+	Contracts         []UpgradeContractRoute `json:"contracts"`
+	NumberOfContracts int                    `json:"number_of_contracts"`
+}
+
+type UpgradeDecodedPubkeys struct {
+	Type             string `json:"type"`
+	NumberOfAccounts int    `json:"number_of_accounts"`
+}
+
+// UpgradeUnsupportedPubkey reports source account pubkey which was dropped, account sets pubkey with its first transaction
+type UpgradeUnsupportedPubkey struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+}
+
+type UpgradePubkeys struct {
+	Decoded             []UpgradeDecodedPubkeys    `json:"decoded"` // Pubkeys decoded from source accounts, per type
+	Unsupported         []UpgradeUnsupportedPubkey `json:"unsupported,omitempty"`
+	NumberOfUnsupported int                        `json:"number_of_unsupported"`
+}
+
+type UpgradeToleranceCheck struct {
+	Name         string    `json:"name"`
+	Balance      string    `json:"balance"`
+	MaxTolerated types.Int `json:"max_tolerated"`
+	Passed       bool      `json:"passed"`
+}
+
+type UpgradeRoundingAdjustment struct {
+	Address     string      `json:"address"`
+	LedgerEntry int         `json:"ledger_entry"`
+	Remainder   types.Dec   `json:"remainder"`
+	Amount      types.Coins `json:"amount"`
+}
+
+type UpgradeRoundingRemainders struct {
+	Policy                  string                      `json:"policy"`
+	NumberOfRemainders      int                         `json:"number_of_remainders"`
+	AggregatedRemainder     types.DecCoins              `json:"aggregated_remainder"`
+	DistributedAmount       types.Coins                 `json:"distributed_amount"`
+	ResidualRemainder       types.DecCoins              `json:"residual_remainder"`
+	PoolAddress             string                      `json:"pool_address,omitempty"`
+	Adjustments             []UpgradeRoundingAdjustment `json:"adjustments,omitempty"`
+	NumberOfAdjustments     int                         `json:"number_of_adjustments,omitempty"`
+	NumberOfExcludedVesting int                         `json:"number_of_excluded_vesting,omitempty"` // Remainders of vesting accounts are left in residual remainder
+}
+
 type ValidatorBalance struct {
 	Validator string      `json:"validator"`
 	Balance   types.Coins `json:"balance"`
@@ -316,3 +570,13 @@ func RegisterVestingCollision(manifest *UpgradeManifest, originalAccount *Accoun
 	manifest.VestingCollision.NumberOfCollisions = len(manifest.VestingCollision.Collisions)
 	return nil
 }
+
+func registerToleranceCheck(manifest *UpgradeManifest, name string, balance fmt.Stringer, maxTolerated types.Int, checkErr error) {
+	toleranceCheck := UpgradeToleranceCheck{
+		Name:         name,
+		Balance:      balance.String(),
+		MaxTolerated: maxTolerated,
+		Passed:       checkErr == nil,
+	}
+	manifest.ToleranceChecks = append(manifest.ToleranceChecks, toleranceCheck)
+}
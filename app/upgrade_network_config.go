@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 )
 
 var (
@@ -59,231 +60,6 @@ var ReconciliationRecords = map[string]*[][]string{
 	"dorado-1":              readInputReconciliationData(reconciliationDataTestnet),
 }
 
-var NetworkInfos = map[string]NetworkConfig{
-	"fetchhub-4": {
-		ReconciliationInfo: &ReconciliationInfo{
-			TargetAddress:   "fetch1tynmzk68pq6kzawqffrqdhquq475gw9ccmlf9gk24mxjjy6ugl3q70aeyd",
-			InputCSVRecords: readInputReconciliationData(reconciliationData),
-		},
-		Contracts: &ContractSet{
-			Reconciliation: &Reconciliation{
-				Addr:     "fetch1tynmzk68pq6kzawqffrqdhquq475gw9ccmlf9gk24mxjjy6ugl3q70aeyd",
-				NewAdmin: getStringPtr("fetch15p3rl5aavw9rtu86tna5lgxfkz67zzr6ed4yhw"),
-				NewLabel: getStringPtr("reconciliation-contract"),
-				NewContractVersion: &ContractVersion{
-					CW2version: &CW2ContractVersion{
-						Contract: "contract-fetch-reconciliation",
-						Version:  "1.0.0",
-					},
-				},
-			},
-			Almanac: &ProdDevContract{
-				ProdAddr: "fetch1mezzhfj7qgveewzwzdk6lz5sae4dunpmmsjr9u7z0tpmdsae8zmquq3y0y",
-			},
-			AName: &ProdDevContract{
-				ProdAddr: "fetch1479lwv5vy8skute5cycuz727e55spkhxut0valrcm38x9caa2x8q99ef0q",
-			},
-			TokenBridge: &TokenBridge{
-				Addr:     "fetch1qxxlalvsdjd07p07y3rc5fu6ll8k4tmetpha8n",
-				NewAdmin: getStringPtr("fetch15p3rl5aavw9rtu86tna5lgxfkz67zzr6ed4yhw"),
-			},
-		},
-		CudosMerge: &CudosMergeConfigJSON{
-			IbcTargetAddr:                    "cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv", // Replace!!
-			RemainingStakingBalanceAddr:      "cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv", // Replace!!
-			RemainingGravityBalanceAddr:      "cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv", // Replace!!
-			RemainingDistributionBalanceAddr: "cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv", // Replace!!
-			ContractDestinationFallbackAddr:  "cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv", // Replace!!
-			GenericModuleRemainingBalance:    "cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv", // Replace!!
-
-			CommissionFetchAddr:          "fetch122j02czdt5ca8cf576wy2hassyxyx67wg5xmgc", // Replace!!
-			ExtraSupplyFetchAddr:         "fetch122j02czdt5ca8cf576wy2hassyxyx67wg5xmgc", // Replace!!
-			VestingCollisionDestAddr:     "fetch122j02czdt5ca8cf576wy2hassyxyx67wg5xmgc", // Replace!!
-			CommunityPoolBalanceDestAddr: "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-
-			VestingPeriod:    3 * 30 * 24 * 60 * 60, // 3 months period
-			NewMaxValidators: 91,
-
-			BalanceConversionConstants: []Pair[string, sdk.Dec]{
-				{"acudos", newDec("118.344")},
-			},
-
-			TotalCudosSupply: newInt("10000000000000000000000000000"),
-
-			TotalFetchSupplyToMint: newInt("88946755672000000000000000"),
-
-			NotVestedAccounts: []string{
-				"cudos1qqz5ezf9ylgft0eq97d66v5aakynux540ds9mv",
-			},
-
-			NotDelegatedAccounts: []string{
-				"cudos1qx3yaanre054nlq84qdzufsjmrrxcqxwzdkh6c",
-			},
-
-			MovedAccounts: []BalanceMovement{
-				BalanceMovement{"cudos1h6r6g0pwq7kcys5jcvfm9r7gcj3n2753hvk2ym", "cudos1w63ph9e4l07vpx7xdnje43cr2tlnr4jsfm4mvq", nil, ""},
-				BalanceMovement{"cudos1jxyc7lny4q7te6sj5xyt9j86kyz82vlfdprl4a", "cudos1tfmkdzx9hm8g28vpgc3xhhxjjn460wzkwtayxr", nil, ""},
-			},
-
-			BackupValidators: []string{"fetchvaloper14w6a4al72uc3fpfy4lqtg0a7xtkx3w7hda0vel"},
-			ValidatorsMap: []Pair[string, string]{
-				{"cudosvaloper1s5qa3dpghnre6dqfgfhudxqjhwsv0mx43xayku", "fetchvaloper14w6a4al72uc3fpfy4lqtg0a7xtkx3w7hda0vel"},
-				{"cudosvaloper1ctcrpuyumt60733u0yd5htwzedgfae0n8gql5n", "fetchvaloper14w6a4al72uc3fpfy4lqtg0a7xtkx3w7hda0vel"},
-			},
-		},
-	},
-
-	"dorado-1": {
-		ReconciliationInfo: &ReconciliationInfo{
-			TargetAddress:   "fetch1g5ur2wc5xnlc7sw9wd895lw7mmxz04r5syj3s6ew8md6pvwuweqqavkgt0",
-			InputCSVRecords: readInputReconciliationData(reconciliationDataTestnet),
-		},
-		Contracts: &ContractSet{
-			Reconciliation: &Reconciliation{
-				Addr: "fetch1g5ur2wc5xnlc7sw9wd895lw7mmxz04r5syj3s6ew8md6pvwuweqqavkgt0",
-				NewContractVersion: &ContractVersion{
-					CW2version: &CW2ContractVersion{
-						Contract: "contract-fetch-reconciliation",
-						Version:  "1.0.0",
-					},
-				},
-			},
-			Almanac: &ProdDevContract{
-				ProdAddr: "fetch1tjagw8g8nn4cwuw00cf0m5tl4l6wfw9c0ue507fhx9e3yrsck8zs0l3q4w",
-				DevAddr:  "fetch135h26ys2nwqealykzey532gamw4l4s07aewpwc0cyd8z6m92vyhsplf0vp",
-			},
-			AName: &ProdDevContract{
-				ProdAddr: "fetch1mxz8kn3l5ksaftx8a9pj9a6prpzk2uhxnqdkwuqvuh37tw80xu6qges77l",
-				DevAddr:  "fetch1kewgfwxwtuxcnppr547wj6sd0e5fkckyp48dazsh89hll59epgpspmh0tn",
-			},
-		},
-		CudosMerge: &CudosMergeConfigJSON{
-			IbcTargetAddr:                    "cudos1c3qgr4df6u3awsz6rqwkxcpsef7aau7p23pew5",
-			RemainingStakingBalanceAddr:      "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-			RemainingGravityBalanceAddr:      "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-			RemainingDistributionBalanceAddr: "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-			ContractDestinationFallbackAddr:  "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-			GenericModuleRemainingBalance:    "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-
-			CommissionFetchAddr:          "fetch15p3rl5aavw9rtu86tna5lgxfkz67zzr6ed4yhw", // Fetch ecosystem wallet
-			ExtraSupplyFetchAddr:         "fetch1wp8fl6fl4je40cfh2reeyj6cvucve9s6antdav",
-			VestingCollisionDestAddr:     "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-			CommunityPoolBalanceDestAddr: "cudos1dslwarknhfsw3pfjzxxf5mn28q3ewfectw0gta",
-
-			VestingPeriod: 3 * 30 * 24 * 60 * 60, // 3 months period
-
-			BalanceConversionConstants: []Pair[string, sdk.Dec]{
-				{"acudos", newDec("266.629")}},
-
-			TotalCudosSupply:       newInt("22530000000000000000000000000"),
-			TotalFetchSupplyToMint: newInt("88946755672000000000000000"),
-
-			NotVestedAccounts: []string{
-				"cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-				"cudos15jpukx39rtkt8w3u3gzwwvyptdeyejcjade6he",
-				"fetch15p3rl5aavw9rtu86tna5lgxfkz67zzr6ed4yhw",
-			},
-
-			NotDelegatedAccounts: []string{
-				"cudos1dslwarknhfsw3pfjzxxf5mn28q3ewfectw0gta",
-				"cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5",
-				"cudos15jpukx39rtkt8w3u3gzwwvyptdeyejcjade6he",
-			},
-
-			// Active wallets given by Cudos
-			//cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5
-			//cudos1c3qgr4df6u3awsz6rqwkxcpsef7aau7p23pew5
-			//cudos1dslwarknhfsw3pfjzxxf5mn28q3ewfectw0gta
-			//cudos15jpukx39rtkt8w3u3gzwwvyptdeyejcjade6he
-
-			MovedAccounts: []BalanceMovement{
-				{"cudos196nrmandtwz67d8h4h0ux7amlcluecglx00wlw", "cudos1nj49l56x7sss5hqyvfmctxr3mq64whg273g3x5", newIntRef("10000"), ""}, // Replace this
-				{"cudos1xcwjdw09cc9dyshr4gt5520sgsh582mjj03jge", "cudos1dslwarknhfsw3pfjzxxf5mn28q3ewfectw0gta", nil, ""},                // Replace this
-				{"cudos1ejmf96efvjp6pmsaj8djv3gpmnsvmpnctger4v", "cudos15p3rl5aavw9rtu86tna5lgxfkz67zzr6tp4ltv", nil, ""},                // Replace this
-			},
-
-			BackupValidators: []string{"fetchvaloper1m9cjw6xgt04f9ddw25fff3cfe2exgwk07eu46u", "fetchvaloper122j02czdt5ca8cf576wy2hassyxyx67wdsecml"},
-			ValidatorsMap: []Pair[string, string]{
-				{"cudosvaloper1qr5rt72yf7s340azajpxay6hw3z5ldner7r4jv", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1vz78ezuzskf9fgnjkmeks75xum49hug6zeqfc4", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				//{"cudosvaloper1v08h0h7sv3wm0t9uaz6x2q26p0g63tyzw68ynj", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"}, // Active
-				{"cudosvaloper1n8lx8qac4d3gj63m4av29q755hy83kchzfkshd", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper16ndhv2a69jrwv32e0smpz6fy57kdsx36egshcm", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1vlpn2rne3vrms9gtjpwl2vfx2mky5dmv44rqqr", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1suxdyst8l9zw64rs8nd9yfygvlynjs7sqcqvfw", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1n738v30yvnge2cc3lq773d8vyvqj3rejnspa3r", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper15juh20tsfmx3ezustkn4ph7as67rcd5q4hv259", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1zsc3uv725d59t654t5vcmcflt2k68ahfvxqd6k", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1j9csyu6dptzwhrmv9fyhaw2hzw44mn5jjg60uz", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				//{"cudosvaloper14ephhlg2pmjgw2rzf7wqgynrutrdlp2yh5c7m7", "fetchvaloper1m9cjw6xgt04f9ddw25fff3cfe2exgwk07eu46u", // Active (map to Cudos validator)
-				{"cudosvaloper1hap6rg0kk0pgmew5vua99tm2ue96f8aahyw4zj", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1avnl53xv6kj7dk5u35jlk52vxwg95n9zlh3jl8", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper17594fddghhfjfwl634qj82tkqtq6wqt9x7aqea", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1zje9zjjx3k8u3g6d57daxq4q9wpsqqyfvzpu3c", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1r9nsfhl2ul5alytrqlsvynexg7563s9nxn3fjn", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1dslwarknhfsw3pfjzxxf5mn28q3ewfeckapf2q", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1kw3y4p2gc5u025wl2wwyek94yxwdcgj3nupvtj", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1pasz9ppwxggyty7fl5745c6lfqrs8g2shhs74e", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1tmtmme96cuj0fn94xg463dq3zjfy8ad0uxsc4u", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1wkd0e3zzamaa2xwawe5tvh80n0qzrcwj7pzgdq", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper10hpr2qpmmp3da7trujcezutx3vaenysruemsd7", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper134a4es94hjqqej732cymf0w3988zh3c4pqfy0s", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1n746qrg5ja87mfu9y6u0acwe20jz045uky99le", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper14rqsrn8mr2lcyzra2dy77emgurw00tlm4dxj8u", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1evkgaxd24y7n5cghgh6d4q9wcr8tuft8wlrv06", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper19hyhj7ace4r6uppv4ll7vh8mfzv4ed6x0dtl4l", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1tutujcdd40rcdjlmuuxtvqsyfspdr64ft6mnnv", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper16ksrhugywq8yewndp0thsceqwgcupjvras48xl", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1a08d0kwjgns5w09narc4zvfmp349dlch2j7j7x", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1zdhktkrjyye0vn877rg40unec0mele5e4uxrav", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1yvd9h3nhukllwdhzy6r48q82aq900nwf8wfdvt", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1g03p85mj85rzkntt5u5qzjw7k5hedwwz0xre4h", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1spx72c3lskj7jh4svauy7cnez4e7wf4zavegrv", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper16c50t6wwfagzcswdnmnk2f5ntdyrjsxyzjjgkf", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1uckrunvmqugrhem0hlu9r8j08x0uhufryc9mc6", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper17lkl2ce4ee440teswxr757kafyz3m9x655pq7g", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1y9ag39jrrdqq0wmadd7a49nqxzcnjr2qf48nze", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper12jk94gydmc20ygn68c6ly0cans3zytvx2svdwy", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1d0new9u2f80rcmmlw0zftxeh0385c2gwugrdx3", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper10ku077u0mfgj5pt8mla8hd4n0lw9yyqj7p68z0", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1esx247nhaal7epksdwh8zpa25vtjvy570lqa48", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1a0gdnlchyh5flrtqmvvpdcxf3tk5kgngtufwg3", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1zd6jfttjrh8rr6hkkce7v550wsrsx9lsc0w8sq", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1wvqepntffmqfzngujy24x6zue49u724twt5zlf", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1e57dml5afa2gy5wrv9wd0dhc7jsca6cdjkd9a4", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper19x6hxpuasshs80vy39j2kh2rcjhz0982wwz9r0", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1xgqr7pn80g6w398wzdmye3lu6wsgk32sq5zz89", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1f245xp5v3gg5cuwz4eg3j42mxhjyqhy0629nec", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1wyv883wzp84d7hzf3q2jeq3l43aga72td8spvs", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1sh38ackq0aquq9kd6s2dsfxm43vwpxgf98le9q", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1jxyc7lny4q7te6sj5xyt9j86kyz82vlfsjd75q", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1j5ssarsg0yqah4a3s0ejhkenlldg65mt0vpudr", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1ns848uhesnue734232srhgjf5vfyuhd4spt2kk", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1n7t5l7ck9slnnpl5pt0smua0p34qfhusv8rfsf", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper16n9r2nkg06ewuun9hmkt8c6urjsyv3ruee3ypj", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1706mmp25jy7s6xymdm9ar8pvny8eynwa8kxycp", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1x5wgh6vwye60wv3dtshs9dmqggwfx2ld0zt8n8", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1ayq6mrlk5neyuugv7u0wt2tn8zf7e6dxhp2cd2", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1a025fyry0gpk0c9sec00u938jvevvvjevp5a8e", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper18ygfl4ahy988k4skf6mat0ya8n3sj565qqufdf", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1gz2c6rkwf9quztvwynwr2hsa9lmldnjlu2ypxq", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1anrcmsms8l3rm0td3qspm375vf3pu329gjv3xx", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper17h6h89mm5jxhxjsqjlxths8g8f5xhw40ss4nfa", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1qx9agmqrruhhyhmdz4ncxdkl769fv7kc0xfk8c", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper1per8wmhqh9868j42v8a4rfmkdef5eymap2yde6", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1y4ye66cymhnl0pexpcja84ujcjjg0dr0gkjlw0", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper18mqn8vssedje6ux24apmpl5y8fsn7ajv2evdpk", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper125xd54s26aylmnys3ruh2yrtyw5p2wjfhqrgzt", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1sda6ngnryjv8lpqefpdqycl2ttg7gcspkf3tvc", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1nutnsjtxx37spn2juhgn046e6p324nx537eclp", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-				{"cudosvaloper16q3f9ech805pa995x9u5g3hqk0cvtah8mnaepp", "fetchvaloper1rsane988vksrgp2mlqzclmt8wucxv0ej4hrn2k"},
-				{"cudosvaloper1mnc7gm9sazrmcfdkshhmx3f0s4n2wp94gavnng", "fetchvaloper1je7r8yuqgaf5f2tx4z2f9008wp4jx0ct6msnzg"},
-				{"cudosvaloper1aqndlcfcyw3adhwe24gngnw7e8hy69v79jdh3l", "fetchvaloper1edqmkwy4rh87020rvf9xn7kktyu7x894led46w"},
-			},
-		},
-	},
-}
-
 type NetworkConfig struct {
 	MergeSourceChainID string `json:"merge_source_chain_id"`
 	DestinationChainID string `json:"destination_chain_id"`
@@ -307,22 +83,63 @@ func LoadNetworkConfigFromFile(configFilePath string) (*NetworkConfig, *[]byte,
 		return nil, nil, fmt.Errorf("failed to read config file: %v", err)
 	}
 
+	config, err := ParseNetworkConfig(byteValue, filepath.Dir(configFilePath))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return config, &byteValue, nil
+}
+
+// resolveConfigFilePath resolves relative path of external file against directory of the config file, config without
+// directory, e.g. built-in one, can refer to absolute paths only, so the file does not depend on working directory of the node
+func resolveConfigFilePath(path string, configDir string) (string, error) {
+	if filepath.IsAbs(path) {
+		return path, nil
+	}
+	if configDir == "" {
+		return "", fmt.Errorf("relative path \"%s\" can not be resolved, config has no directory", path)
+	}
+	return filepath.Join(configDir, path), nil
+}
+
+// ParseNetworkConfig parses network config JSON and loads external files it refers to, relative paths are resolved against configDir
+func ParseNetworkConfig(byteValue []byte, configDir string) (*NetworkConfig, error) {
 	// Initialize an empty struct to hold the JSON data
 	var config NetworkConfig
 
 	// Unmarshal the JSON data into the struct
-	err = json.Unmarshal(byteValue, &config)
+	err := json.Unmarshal(byteValue, &config)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal JSON: %v", err)
+		return nil, fmt.Errorf("failed to unmarshal JSON: %v", err)
+	}
+
+	if config.ReconciliationInfo != nil {
+		err = config.ReconciliationInfo.resolveInputCSVRecords(config.DestinationChainID, configDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load reconciliation data: %w", err)
+		}
 	}
 
-	if config.ReconciliationInfo.InputCSVRecords == nil {
-		if val, exists := ReconciliationRecords[config.DestinationChainID]; exists {
-			config.ReconciliationInfo.InputCSVRecords = val
+	if config.Contracts != nil {
+		for i := range config.Contracts.Migrations {
+			err = config.Contracts.Migrations[i].resolveWasmCode(configDir)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load contract code: %w", err)
+			}
 		}
 	}
 
-	return &config, &byteValue, nil
+	if config.CudosMerge != nil {
+		for i := range config.CudosMerge.ContractRoutes {
+			err = config.CudosMerge.ContractRoutes[i].resolveBeneficiaries(configDir)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &config, nil
 }
 
 func LoadAndVerifyNetworkConfigFromFile(configFilePath string, expectedSha256Hex *string) (*NetworkConfig, error) {
@@ -358,21 +175,38 @@ type CudosMergeConfigJSON struct {
 	NewMaxValidators uint32 `json:"new_max_validators,omitempty"` // Set new value for staking params max validators
 
 	BalanceConversionConstants []Pair[string, sdk.Dec] `json:"balance_conversion_constants,omitempty"`
+	ConversionTiers            []ConversionTier        `json:"conversion_tiers,omitempty"` // Per-address or per-account-type overrides of conversion constants
+
+	RoundingRemainderPolicy   string `json:"rounding_remainder_policy,omitempty"`    // "pool" (default) or "largest_remainder", which rounds up migrated non-vesting user accounts only
+	RoundingRemainderDestAddr string `json:"rounding_remainder_dest_addr,omitempty"` // Fetch address for pooled rounding remainders, they end up in remaining mint balance if not set
+
+	DustThreshold *sdk.Int `json:"dust_threshold,omitempty"` // Accounts with converted balance below threshold, in destination denom, are not migrated to destination accounts
+	DustPolicy    string   `json:"dust_policy,omitempty"`    // "aggregate" or "burn", required if dust threshold is set
+	DustDestAddr  string   `json:"dust_dest_addr,omitempty"` // Fetch address for aggregated dust balances, required by "aggregate" policy only
 
 	TotalCudosSupply       sdk.Int `json:"total_cudos_supply"`
 	TotalFetchSupplyToMint sdk.Int `json:"total_fetch_supply_to_mint"`
 
-	NotVestedAccounts    []string          `json:"not_vested_accounts,omitempty"`
-	NotDelegatedAccounts []string          `json:"not_delegated_accounts,omitempty"`
-	MovedAccounts        []BalanceMovement `json:"moved_accounts,omitempty"`
+	NotVestedAccounts    []string           `json:"not_vested_accounts,omitempty"`
+	VestingSchedules     []VestingSchedule  `json:"vesting_schedules,omitempty"` // Vesting options per class of accounts
+	NotDelegatedAccounts []string           `json:"not_delegated_accounts,omitempty"`
+	PolicyDelegations    []PolicyDelegation `json:"policy_delegations,omitempty"` // Liquid balances of not delegated accounts to be delegated
+	MovedAccounts        []BalanceMovement  `json:"moved_accounts,omitempty"`
 
 	ValidatorsMap []Pair[string, string] `json:"validators_map,omitempty"`
 
+	ContractRoutes []ContractRoute `json:"contract_routes,omitempty"` // Routing of balances, delegations and gov deposits of listed contracts instead of their admin or fallback address
+
 	BackupValidators []string `json:"backup_validators,omitempty"`
 
+	JailedValidatorPolicy     string `json:"jailed_validator_policy,omitempty"`     // "redelegate" (default), "liquid" or "backup"
+	TombstonedValidatorPolicy string `json:"tombstoned_validator_policy,omitempty"` // Same options as jailed validator policy, which it defaults to
+
 	MaxToleratedRemainingDistributionBalance *sdk.Int `json:"max_remaining_distribution_module_balance,omitempty"`
 	MaxToleratedRemainingStakingBalance      *sdk.Int `json:"max_remaining_staking_module_balance,omitempty"`
 	MaxToleratedRemainingMintBalance         *sdk.Int `json:"max_remaining_mint_module_balance,omitempty"`
+
+	Audit *UpgradeAuditConfigJSON `json:"audit,omitempty"` // Post-upgrade self-audit thresholds
 }
 
 type CudosMergeConfig struct {
@@ -381,9 +215,14 @@ type CudosMergeConfig struct {
 	BalanceConversionConstants *OrderedMap[string, sdk.Dec]
 
 	NotVestedAccounts    *OrderedMap[string, bool]
+	VestingSchedules     *OrderedMap[string, *VestingSchedule]
 	NotDelegatedAccounts *OrderedMap[string, bool]
+	PolicyDelegations    *OrderedMap[string, *PolicyDelegation]
 
 	ValidatorsMap *OrderedMap[string, string]
+
+	ContractRoutes  *OrderedMap[string, *ContractRoute]
+	EscrowAddresses *OrderedMap[string, bool]
 }
 
 func NewCudosMergeConfig(config *CudosMergeConfigJSON) *CudosMergeConfig {
@@ -392,16 +231,117 @@ func NewCudosMergeConfig(config *CudosMergeConfigJSON) *CudosMergeConfig {
 
 	retval.BalanceConversionConstants = NewOrderedMapFromPairs(config.BalanceConversionConstants)
 	retval.NotVestedAccounts = NewOrderedSet(config.NotVestedAccounts)
+
+	retval.VestingSchedules = NewOrderedMap[string, *VestingSchedule]()
+	for i := range config.VestingSchedules {
+		schedule := &config.VestingSchedules[i]
+		for _, account := range schedule.Accounts {
+			// Duplicates are refused by config verification, the first schedule wins until then
+			if !retval.VestingSchedules.Has(account) {
+				retval.VestingSchedules.Set(account, schedule)
+			}
+		}
+	}
 	retval.NotDelegatedAccounts = NewOrderedSet(config.NotDelegatedAccounts)
 
+	retval.PolicyDelegations = NewOrderedMap[string, *PolicyDelegation]()
+	for i := range config.PolicyDelegations {
+		retval.PolicyDelegations.Set(config.PolicyDelegations[i].Account, &config.PolicyDelegations[i])
+	}
+
 	retval.ValidatorsMap = NewOrderedMapFromPairs(config.ValidatorsMap)
 
+	retval.ContractRoutes = NewOrderedMap[string, *ContractRoute]()
+	retval.EscrowAddresses = NewOrderedMap[string, bool]()
+	for i := range config.ContractRoutes {
+		retval.ContractRoutes.Set(config.ContractRoutes[i].Contract, &config.ContractRoutes[i])
+		// Invalid contract address is reported by config verification
+		if config.ContractRoutes[i].Type == ContractRouteEscrow {
+			if escrowAddr, err := getContractEscrowAddress(config.ContractRoutes[i].Contract); err == nil {
+				retval.EscrowAddresses.Set(escrowAddr, true)
+			}
+		}
+	}
+
 	return retval
 }
 
 type ReconciliationInfo struct {
 	TargetAddress   string      `json:"target_address"`
 	InputCSVRecords *[][]string `json:"input_csv_records,omitempty"`
+	InputCSVPath    string      `json:"input_csv_path,omitempty"`   // External reconciliation data file, relative path is resolved against directory of the config file, built-in configs accept absolute path only
+	InputCSVSha256  string      `json:"input_csv_sha256,omitempty"` // Expected sha256 of the external reconciliation data file, required if the path is set
+
+	MaxRejectedRecords int `json:"max_rejected_records,omitempty"` // Number of inconsistent records which are tolerated and skipped, any rejected record fails the upgrade by default
+
+	Eligibility *ReconciliationEligibility `json:"eligibility,omitempty"`
+}
+
+// resolveInputCSVRecords loads reconciliation records from the external file if given, falling back to embedded data for the chain
+func (info *ReconciliationInfo) resolveInputCSVRecords(destinationChainID string, configDir string) error {
+	if info.InputCSVPath != "" {
+		if info.InputCSVRecords != nil {
+			return fmt.Errorf("reconciliation records can not be provided both inline and as file")
+		}
+
+		csvFilePath, err := resolveConfigFilePath(info.InputCSVPath, configDir)
+		if err != nil {
+			return err
+		}
+
+		records, err := LoadReconciliationDataFromFile(csvFilePath, info.InputCSVSha256)
+		if err != nil {
+			return err
+		}
+		info.InputCSVRecords = records
+
+		return nil
+	}
+
+	if info.InputCSVRecords == nil {
+		if val, exists := ReconciliationRecords[destinationChainID]; exists {
+			info.InputCSVRecords = val
+		}
+	}
+
+	return nil
+}
+
+// SetInputCSVFile loads reconciliation records from the external file given outside of the config, records embedded
+// in the binary or given inline are replaced
+func (info *ReconciliationInfo) SetInputCSVFile(csvFilePath string, expectedSha256Hex string) error {
+	if info.InputCSVPath != "" {
+		return fmt.Errorf("reconciliation data file \"%s\" is already set in the config", info.InputCSVPath)
+	}
+
+	records, err := LoadReconciliationDataFromFile(csvFilePath, expectedSha256Hex)
+	if err != nil {
+		return err
+	}
+
+	info.InputCSVRecords = records
+	info.InputCSVPath = csvFilePath
+	info.InputCSVSha256 = expectedSha256Hex
+
+	return nil
+}
+
+// VerifyRecords returns issues of inconsistent reconciliation records and fails if there are more of them than tolerated
+func (info *ReconciliationInfo) VerifyRecords(addrPrefix string) ([]ReconciliationRecordIssue, error) {
+	if info.InputCSVRecords == nil {
+		return nil, nil
+	}
+
+	if info.MaxRejectedRecords < 0 {
+		return nil, fmt.Errorf("maximum number of rejected reconciliation records %d is negative", info.MaxRejectedRecords)
+	}
+
+	issues := ValidateReconciliationRecords(*info.InputCSVRecords, addrPrefix)
+	if len(issues) > info.MaxRejectedRecords {
+		return issues, fmt.Errorf("%d reconciliation records are inconsistent, at most %d are tolerated, first issue in row %d: %s", len(issues), info.MaxRejectedRecords, issues[0].Row, issues[0].Reason)
+	}
+
+	return issues, nil
 }
 
 type ContractSet struct {
@@ -409,6 +349,11 @@ type ContractSet struct {
 	TokenBridge    *TokenBridge     `json:"token_bridge,omitempty"`
 	Almanac        *ProdDevContract `json:"almanac,omitempty"`
 	AName          *ProdDevContract `json:"a_name,omitempty"`
+
+	Operations []ContractOperation `json:"operations,omitempty"` // Applied in the given order after contract admins, labels and versions are updated
+	Migrations []ContractMigration `json:"migrations,omitempty"` // Applied in the given order after contract operations
+
+	AdminRotations []ContractAdminRotation `json:"admin_rotations,omitempty"` // Applied after admins of the contracts above are updated
 }
 
 type IContractBase interface {
@@ -531,8 +476,9 @@ func (c *Reconciliation) GetNewVersion() *ContractVersion {
 }
 
 type ProdDevContract struct {
-	DevAddr  string `json:"dev_addr"`
-	ProdAddr string `json:"prod_addr"`
+	DevAddr  string                `json:"dev_addr"`
+	ProdAddr string                `json:"prod_addr"`
+	Prune    *ContractStatePruning `json:"prune,omitempty"` // Removes only selected records, whole state is removed if not set
 }
 
 func (c *ProdDevContract) GetContracts(contracts []string) []string {
@@ -675,6 +621,64 @@ func VerifyConfig(cudosCfg *CudosMergeConfig, sourceAddrPrefix string, DestAddrP
 		return fmt.Errorf("list of conversion constants is empty")
 	}
 
+	for _, conversionConstant := range cudosCfg.Config.BalanceConversionConstants {
+		if !conversionConstant.Value.IsPositive() {
+			return fmt.Errorf("conversion constant %s for denom %s is not positive", conversionConstant.Value, conversionConstant.Key)
+		}
+	}
+
+	err = verifyConversionTiers(cudosCfg, sourceAddrPrefix)
+	if err != nil {
+		return err
+	}
+
+	if !cudosCfg.Config.TotalFetchSupplyToMint.IsPositive() {
+		return fmt.Errorf("total fetch supply to mint %s is not positive", cudosCfg.Config.TotalFetchSupplyToMint)
+	}
+
+	switch cudosCfg.Config.RoundingRemainderPolicy {
+	case "", RoundingRemainderPolicyPool:
+	case RoundingRemainderPolicyLargestRemainder:
+		if cudosCfg.Config.RoundingRemainderDestAddr != "" {
+			return fmt.Errorf("rounding remainder destination address can not be used with \"%s\" policy", RoundingRemainderPolicyLargestRemainder)
+		}
+	default:
+		return fmt.Errorf("unknown rounding remainder policy \"%s\"", cudosCfg.Config.RoundingRemainderPolicy)
+	}
+
+	// Rounding remainder address is optional
+	if cudosCfg.Config.RoundingRemainderDestAddr != "" {
+		err = verifyAddress(cudosCfg.Config.RoundingRemainderDestAddr, &DestAddrPrefix)
+		if err != nil {
+			return fmt.Errorf("rounding remainder destination address error: %v", err)
+		}
+	}
+
+	err = verifyPolicyDelegations(cudosCfg, sourceAddrPrefix, DestAddrPrefix)
+	if err != nil {
+		return err
+	}
+
+	err = verifyVestingSchedules(cudosCfg, sourceAddrPrefix)
+	if err != nil {
+		return err
+	}
+
+	err = verifyDustConfig(cudosCfg, DestAddrPrefix)
+	if err != nil {
+		return err
+	}
+
+	err = verifyValidatorPolicies(cudosCfg)
+	if err != nil {
+		return err
+	}
+
+	err = verifyContractRoutes(cudosCfg, sourceAddrPrefix)
+	if err != nil {
+		return err
+	}
+
 	if len(cudosCfg.Config.BackupValidators) == 0 {
 		return fmt.Errorf("list of backup validators is empty")
 	}
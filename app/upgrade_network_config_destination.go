@@ -0,0 +1,194 @@
+package app
+
+import (
+	"fmt"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"strings"
+)
+
+// DestinationState holds parts of the destination chain state, as exported by `fetchd export`, which network config refers to
+type DestinationState struct {
+	ChainID       string
+	BlockHeight   int64
+	MaxValidators uint32
+	Validators    *OrderedMap[string, stakingtypes.Validator]
+	Accounts      *OrderedMap[string, bool]
+	Contracts     *OrderedMap[string, wasmTypes.ContractInfo]
+}
+
+// ContractAdminCheck reports current admin of the contract in destination state together with the admin set by the config
+type ContractAdminCheck struct {
+	Contract      string
+	CurrentAdmin  string
+	ExpectedAdmin *string
+	NewAdmin      *string
+}
+
+func LoadDestinationStateFromFile(genesisFilePath string, cdc codec.Codec) (*DestinationState, error) {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genesisFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal destination genesis state: %w", err)
+	}
+
+	state := &DestinationState{
+		ChainID:     genDoc.ChainID,
+		BlockHeight: genDoc.InitialHeight,
+		Validators:  NewOrderedMap[string, stakingtypes.Validator](),
+		Accounts:    NewOrderedMap[string, bool](),
+		Contracts:   NewOrderedMap[string, wasmTypes.ContractInfo](),
+	}
+
+	var stakingGenesis stakingtypes.GenesisState
+	if err = cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal destination staking state: %w", err)
+	}
+	state.MaxValidators = stakingGenesis.Params.MaxValidators
+	for _, validator := range stakingGenesis.Validators {
+		state.Validators.Set(validator.OperatorAddress, validator)
+	}
+
+	authGenesis := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack destination accounts: %w", err)
+	}
+	for _, account := range accounts {
+		state.Accounts.Set(account.GetAddress().String(), true)
+	}
+
+	var wasmGenesis wasmTypes.GenesisState
+	if err = cdc.UnmarshalJSON(appState[wasmTypes.ModuleName], &wasmGenesis); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal destination wasm state: %w", err)
+	}
+	for _, contract := range wasmGenesis.Contracts {
+		state.Contracts.Set(contract.ContractAddress, contract.ContractInfo)
+	}
+
+	return state, nil
+}
+
+// configContractAdmins lists all contracts referred to by the contract set, with the admin the upgrade sets where applicable
+func configContractAdmins(contracts *ContractSet) []ContractAdminCheck {
+	if contracts == nil {
+		return nil
+	}
+
+	var res []ContractAdminCheck
+	for _, contract := range []IContractAdmin{contracts.Reconciliation, contracts.TokenBridge} {
+		if contractAddr := contract.GetPrimaryContractAddr(); contractAddr != nil {
+			res = append(res, ContractAdminCheck{Contract: *contractAddr, NewAdmin: contract.GetNewAdminAddr()})
+		}
+	}
+	for _, contractAddr := range contracts.AName.GetContracts(contracts.Almanac.GetContracts(nil)) {
+		res = append(res, ContractAdminCheck{Contract: contractAddr})
+	}
+	for _, operation := range contracts.Operations {
+		res = append(res, ContractAdminCheck{Contract: operation.Contract})
+	}
+	for _, migration := range contracts.Migrations {
+		res = append(res, ContractAdminCheck{Contract: migration.Contract})
+	}
+	for _, rotation := range contracts.AdminRotations {
+		res = append(res, ContractAdminCheck{Contract: rotation.Contract, ExpectedAdmin: rotation.ExpectedAdmin, NewAdmin: rotation.NewAdmin})
+	}
+
+	return res
+}
+
+func verifyDestinationValidator(state *DestinationState, operatorAddress string) error {
+	validator, exists := state.Validators.Get(operatorAddress)
+	if !exists {
+		return fmt.Errorf("validator %s does not exist", operatorAddress)
+	}
+	if !canReceiveDelegations(&validator) {
+		return fmt.Errorf("validator %s can not receive delegations", operatorAddress)
+	}
+	if !validator.IsBonded() {
+		return fmt.Errorf("validator %s is not bonded, status is %s", operatorAddress, validator.Status)
+	}
+	return nil
+}
+
+// VerifyConfigAgainstDestinationState cross-checks network config against the destination chain state, so that problems
+// which would otherwise surface only in the upgrade handler are found in advance. All problems are reported at once,
+// including admins which differ from the expected ones and new admins which do not exist, admins of the referred
+// contracts are returned for review.
+func VerifyConfigAgainstDestinationState(networkInfo *NetworkConfig, cudosCfg *CudosMergeConfig, state *DestinationState) ([]ContractAdminCheck, error) {
+	var problems []string
+
+	if networkInfo.DestinationChainID != state.ChainID {
+		problems = append(problems, fmt.Sprintf("destination chain id %s is different from exported chain id %s", networkInfo.DestinationChainID, state.ChainID))
+	}
+
+	var destinationValidators []string
+	for validator := range cudosCfg.ValidatorsMap.Iterate() {
+		destinationValidators = append(destinationValidators, validator.Value)
+	}
+	for _, policyDelegation := range cudosCfg.Config.PolicyDelegations {
+		for _, validator := range policyDelegation.Validators {
+			destinationValidators = append(destinationValidators, validator.Key)
+		}
+	}
+	destinationValidators = append(destinationValidators, cudosCfg.Config.BackupValidators...)
+
+	verifiedValidators := NewOrderedMap[string, bool]()
+	for _, validator := range destinationValidators {
+		if verifiedValidators.Has(validator) {
+			continue
+		}
+		verifiedValidators.Set(validator, true)
+		if err := verifyDestinationValidator(state, validator); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	if !state.Accounts.Has(cudosCfg.Config.CommissionFetchAddr) {
+		problems = append(problems, fmt.Sprintf("commission account %s does not exist", cudosCfg.Config.CommissionFetchAddr))
+	}
+	if !state.Accounts.Has(cudosCfg.Config.ExtraSupplyFetchAddr) {
+		problems = append(problems, fmt.Sprintf("extra supply account %s does not exist", cudosCfg.Config.ExtraSupplyFetchAddr))
+	}
+
+	if newMaxValidators := cudosCfg.Config.NewMaxValidators; newMaxValidators != 0 {
+		numberOfBonded := 0
+		for validator := range state.Validators.Iterate() {
+			if validator.Value.IsBonded() {
+				numberOfBonded++
+			}
+		}
+		if newMaxValidators < state.MaxValidators {
+			problems = append(problems, fmt.Sprintf("new max validators %d is smaller than current max validators %d", newMaxValidators, state.MaxValidators))
+		}
+		if int(newMaxValidators) < numberOfBonded {
+			problems = append(problems, fmt.Sprintf("new max validators %d is smaller than current number of bonded validators %d", newMaxValidators, numberOfBonded))
+		}
+	}
+
+	var admins []ContractAdminCheck
+	for _, check := range configContractAdmins(networkInfo.Contracts) {
+		contractInfo, exists := state.Contracts.Get(check.Contract)
+		if !exists {
+			problems = append(problems, fmt.Sprintf("contract %s does not exist", check.Contract))
+			continue
+		}
+		check.CurrentAdmin = contractInfo.Admin
+		admins = append(admins, check)
+
+		if check.ExpectedAdmin != nil && check.CurrentAdmin != *check.ExpectedAdmin {
+			problems = append(problems, fmt.Sprintf("contract %s admin is \"%s\", config expects \"%s\"", check.Contract, check.CurrentAdmin, *check.ExpectedAdmin))
+		}
+		if check.NewAdmin != nil && *check.NewAdmin != "" && !state.Accounts.Has(*check.NewAdmin) && !state.Contracts.Has(*check.NewAdmin) {
+			problems = append(problems, fmt.Sprintf("new admin %s of contract %s does not exist", *check.NewAdmin, check.Contract))
+		}
+	}
+
+	if len(problems) > 0 {
+		return admins, fmt.Errorf("network config does not match destination state at height %d:\n%s", state.BlockHeight, strings.Join(problems, "\n"))
+	}
+
+	return admins, nil
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+)
+
+//go:embed network_configs/*.json
+var builtinNetworkConfigFiles embed.FS
+
+const builtinNetworkConfigDir = "network_configs"
+
+// BuiltinNetworkConfigs is the registry of network configs embedded in the binary, chain id -> sha256 of the config file.
+// Config files are stored in `network_configs/<chain-id>.json` in the same format as the file given by the upgrade flag.
+var BuiltinNetworkConfigs = map[string]string{
+	"fetchhub-4": "2658c873a8d3e2d5df31e685cc52eed2d22f11ea7fd8bc9273cd2b153a93966d",
+	"dorado-1":   "83bb6306e6243336da3433b9d2b75b65600ac0f63cdb9125faa5f206d70416a2",
+}
+
+// BuiltinNetworkConfigChainIDs returns sorted chain ids of all registered network configs
+func BuiltinNetworkConfigChainIDs() []string {
+	chainIDs := make([]string, 0, len(BuiltinNetworkConfigs))
+	for chainID := range BuiltinNetworkConfigs {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	return chainIDs
+}
+
+// GetBuiltinNetworkConfigData returns raw embedded network config of the chain after verifying it against its registered sha256
+func GetBuiltinNetworkConfigData(chainID string) ([]byte, string, error) {
+	expectedSha256, exists := BuiltinNetworkConfigs[chainID]
+	if !exists {
+		return nil, "", fmt.Errorf("built-in network config not found for chain id: %s", chainID)
+	}
+
+	data, err := builtinNetworkConfigFiles.ReadFile(path.Join(builtinNetworkConfigDir, chainID+".json"))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read built-in network config of chain id %s: %w", chainID, err)
+	}
+
+	if isVerified, actualHashHex, err := VerifySha256(data, &expectedSha256); err != nil {
+		return nil, "", err
+	} else if !isVerified {
+		return nil, "", fmt.Errorf("failed to verify sha256: built-in network config of chain id %s hash \"%s\" does not match registered hash \"%s\"", chainID, actualHashHex, expectedSha256)
+	}
+
+	return data, expectedSha256, nil
+}
+
+// LoadBuiltinNetworkConfig parses embedded network config of the chain, returns the config together with its sha256
+func LoadBuiltinNetworkConfig(chainID string) (*NetworkConfig, string, error) {
+	data, sha256Hex, err := GetBuiltinNetworkConfigData(chainID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config, err := ParseNetworkConfig(data, "")
+	if err != nil {
+		return nil, "", fmt.Errorf("built-in network config of chain id %s: %w", chainID, err)
+	}
+
+	if config.DestinationChainID != chainID {
+		return nil, "", fmt.Errorf("built-in network config of chain id %s has destination chain id \"%s\"", chainID, config.DestinationChainID)
+	}
+
+	return config, sha256Hex, nil
+}
@@ -0,0 +1,160 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"sort"
+	"strings"
+)
+
+// NetworkConfigPlaceholderPrefix marks values of the scaffolded network config which must be replaced before use,
+// the rest of the placeholder describes expected value.
+const NetworkConfigPlaceholderPrefix = "REPLACE!!"
+
+const scaffoldVestingPeriod = 3 * 30 * 24 * 60 * 60 // 3 months period
+
+func networkConfigPlaceholder(format string, args ...interface{}) string {
+	return NetworkConfigPlaceholderPrefix + " " + fmt.Sprintf(format, args...)
+}
+
+// NewNetworkConfigScaffold drafts network config from the source genesis. Values which can be derived from genesis are
+// filled, validators and module/contract accounts are listed as candidates, everything else is set to annotated placeholders.
+func NewNetworkConfigScaffold(jsonData map[string]interface{}, genDoc *tmtypes.GenesisDoc) (*NetworkConfig, error) {
+	totalSupply, err := parseGenesisTotalSupply(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total supply: %w", err)
+	}
+
+	prefix, err := GetAccPrefix(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prefix: %w", err)
+	}
+
+	bondDenom, err := GetBondDenom(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staking denom: %w", err)
+	}
+
+	validators, err := parseGenesisValidators(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validators map: %w", err)
+	}
+
+	contracts, err := parseGenesisWasmContracts(jsonData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contracts: %w", err)
+	}
+
+	sourceAddr := func(purpose string) string {
+		return networkConfigPlaceholder("%s address %s", prefix, purpose)
+	}
+	destAddr := func(purpose string) string {
+		return networkConfigPlaceholder("%s address %s", AccountAddressPrefix, purpose)
+	}
+
+	cudosMerge := &CudosMergeConfigJSON{
+		IbcTargetAddr:                    sourceAddr("receiving balances of IBC escrow accounts"),
+		RemainingStakingBalanceAddr:      sourceAddr("receiving remaining bonded and not-bonded pool balances"),
+		RemainingGravityBalanceAddr:      sourceAddr("receiving remaining gravity module balance"),
+		RemainingDistributionBalanceAddr: sourceAddr("receiving remaining distribution module balance"),
+		ContractDestinationFallbackAddr:  sourceAddr("receiving balances of contracts without admin"),
+		GenericModuleRemainingBalance:    sourceAddr("receiving leftover balances of other module accounts"),
+
+		CommissionFetchAddr:      destAddr("receiving validator commissions"),
+		ExtraSupplyFetchAddr:     destAddr("receiving extra supply"),
+		VestingCollisionDestAddr: sourceAddr("receiving vesting balances colliding with existing destination accounts"),
+
+		VestingPeriod: scaffoldVestingPeriod,
+
+		// Conversion constant and supply to mint are agreed off-chain, zero values are refused by verify-config
+		BalanceConversionConstants: []Pair[string, sdk.Dec]{
+			{bondDenom, sdk.ZeroDec()},
+		},
+
+		TotalCudosSupply:       totalSupply.AmountOf(bondDenom),
+		TotalFetchSupplyToMint: sdk.ZeroInt(),
+
+		BackupValidators: []string{networkConfigPlaceholder("%s%s address of backup validator", AccountAddressPrefix, ValAddressPrefix)},
+	}
+
+	// Validators sorted by stake, so that the biggest ones are mapped first
+	validatorList := make([]*ValidatorInfo, 0, len(validators.Keys()))
+	for validator := range validators.Iterate() {
+		validatorList = append(validatorList, validator.Value)
+	}
+	sort.SliceStable(validatorList, func(i, j int) bool {
+		return validatorList[i].Stake.GT(validatorList[j].Stake)
+	})
+	for _, validator := range validatorList {
+		cudosMerge.ValidatorsMap = append(cudosMerge.ValidatorsMap, Pair[string, string]{
+			Key: validator.OperatorAddress,
+			Value: networkConfigPlaceholder("%s%s address of destination validator, source validator is %s with stake %s%s",
+				AccountAddressPrefix, ValAddressPrefix, validator.Status, validator.Stake, bondDenom),
+		})
+	}
+
+	// Module and contract accounts are candidates for not delegated accounts
+	auth := jsonData[authtypes.ModuleName].(map[string]interface{})
+	for _, acc := range auth["accounts"].([]interface{}) {
+		accountInfo, err := parseGenesisAccount(acc.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse account: %w", err)
+		}
+		if accountInfo.AccountType == ModuleAccountType || contracts.Has(accountInfo.Address) {
+			cudosMerge.NotDelegatedAccounts = append(cudosMerge.NotDelegatedAccounts, accountInfo.Address)
+		}
+	}
+
+	return &NetworkConfig{
+		MergeSourceChainID: genDoc.ChainID,
+		DestinationChainID: networkConfigPlaceholder("chain id of the destination network"),
+		CudosMerge:         cudosMerge,
+	}, nil
+}
+
+// collectPlaceholders walks decoded JSON value and appends paths of all placeholder strings
+func collectPlaceholders(value interface{}, path string, placeholders []string) []string {
+	switch val := value.(type) {
+	case string:
+		if strings.HasPrefix(val, NetworkConfigPlaceholderPrefix) {
+			placeholders = append(placeholders, fmt.Sprintf("%s: %s", path, val))
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			placeholders = collectPlaceholders(val[key], path+"."+key, placeholders)
+		}
+	case []interface{}:
+		for i, item := range val {
+			placeholders = collectPlaceholders(item, fmt.Sprintf("%s[%d]", path, i), placeholders)
+		}
+	}
+	return placeholders
+}
+
+// VerifyNoPlaceholders fails if any value of the network config still contains scaffolding placeholder
+func VerifyNoPlaceholders(config *NetworkConfig) error {
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	var decoded interface{}
+	if err = json.Unmarshal(configJSON, &decoded); err != nil {
+		return err
+	}
+
+	placeholders := collectPlaceholders(decoded, "$", nil)
+	if len(placeholders) > 0 {
+		return fmt.Errorf("network config contains %d unreplaced placeholder(s):\n%s", len(placeholders), strings.Join(placeholders, "\n"))
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/types"
+	"os"
 	"strings"
 )
 
@@ -58,13 +59,45 @@ func prefixStringWithLength(val string) []byte {
 	return buffer.Bytes()
 }
 
-func readInputReconciliationData(csvData []byte) *[][]string {
+func parseInputReconciliationData(csvData []byte) (*[][]string, error) {
 	r := csv.NewReader(bytes.NewReader(csvData))
 	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return &records, nil
+}
+
+func readInputReconciliationData(csvData []byte) *[][]string {
+	records, err := parseInputReconciliationData(csvData)
 	if err != nil {
 		panic(fmt.Sprintf("error reading reconciliation data: %v", err))
 	}
-	return &records
+	return records
+}
+
+func LoadReconciliationDataFromFile(csvFilePath string, expectedSha256Hex string) (*[][]string, error) {
+	if expectedSha256Hex == "" {
+		return nil, fmt.Errorf("expected sha256 of reconciliation data file \"%s\" is not set", csvFilePath)
+	}
+
+	csvData, err := os.ReadFile(csvFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reconciliation data file: %w", err)
+	}
+
+	if isVerified, actualHashHex, err := VerifySha256(csvData, &expectedSha256Hex); err != nil {
+		return nil, err
+	} else if !isVerified {
+		return nil, fmt.Errorf("failed to verify sha256: reconciliation data file \"%s\" hash \"%s\" does not match expected hash \"%s\"", csvFilePath, actualHashHex, expectedSha256Hex)
+	}
+
+	records, err := parseInputReconciliationData(csvData)
+	if err != nil {
+		return nil, fmt.Errorf("error reading reconciliation data file \"%s\": %w", csvFilePath, err)
+	}
+
+	return records, nil
 }
 
 func (app *App) ChangeContractLabel(ctx types.Context, contractAddr *string, newLabel *string, manifest *UpgradeManifest) error {
@@ -96,7 +129,7 @@ func (app *App) ChangeContractLabel(ctx types.Context, contractAddr *string, new
 		manifest.Contracts = new(Contracts)
 	}
 
-	manifest.Contracts.LabelUpdated = append(manifest.Contracts.LabelUpdated, ContractValueUpdate{*contractAddr, oldLabel, *newLabel})
+	manifest.Contracts.LabelUpdated = append(manifest.Contracts.LabelUpdated, ContractValueUpdate{Address: *contractAddr, From: oldLabel, To: *newLabel})
 
 	return nil
 }
@@ -185,7 +218,27 @@ func (app *App) ProcessReconciliation(ctx types.Context, networkInfo *NetworkCon
 		return nil
 	}
 
-	err := app.WithdrawReconciliationBalances(ctx, networkInfo, *records, manifest)
+	if manifest.Reconciliation == nil {
+		manifest.Reconciliation = &UpgradeReconciliation{}
+	}
+	if networkInfo.ReconciliationInfo.InputCSVPath != "" {
+		manifest.Reconciliation.InputCSVPath = networkInfo.ReconciliationInfo.InputCSVPath
+		manifest.Reconciliation.InputCSVSha256 = networkInfo.ReconciliationInfo.InputCSVSha256
+	}
+
+	err := networkInfo.ReconciliationInfo.Eligibility.Validate(AccountAddressPrefix)
+	if err != nil {
+		return err
+	}
+
+	issues, err := networkInfo.ReconciliationInfo.VerifyRecords(AccountAddressPrefix)
+	if err != nil {
+		return err
+	}
+
+	validRecords := rejectInvalidReconciliationRecords(*records, issues, manifest)
+
+	err = app.WithdrawReconciliationBalances(ctx, networkInfo, validRecords, manifest)
 	if err != nil {
 		return fmt.Errorf("error withdrawing reconciliation balances: %v", err)
 	}
@@ -198,6 +251,33 @@ func (app *App) ProcessReconciliation(ctx types.Context, networkInfo *NetworkCon
 	return nil
 }
 
+// rejectInvalidReconciliationRecords records tolerated inconsistent records in the manifest and returns only valid ones, so rejected records can not move any funds
+func rejectInvalidReconciliationRecords(records [][]string, issues []ReconciliationRecordIssue, manifest *UpgradeManifest) [][]string {
+	if len(issues) == 0 {
+		return records
+	}
+
+	rejectedRows := make(map[int]bool, len(issues))
+	for _, issue := range issues {
+		rejectedRows[issue.Row] = true
+	}
+
+	var validRecords [][]string
+	for i, record := range records {
+		if !rejectedRows[i+1] {
+			validRecords = append(validRecords, record)
+		}
+	}
+
+	if manifest.Reconciliation == nil {
+		manifest.Reconciliation = &UpgradeReconciliation{}
+	}
+	manifest.Reconciliation.RejectedRecords = issues
+	manifest.Reconciliation.NumberOfRejectedRecords = len(issues)
+
+	return validRecords
+}
+
 func (app *App) WithdrawReconciliationBalances(ctx types.Context, networkInfo *NetworkConfig, records [][]string, manifest *UpgradeManifest) error {
 	landingAddr, err := types.AccAddressFromBech32(networkInfo.ReconciliationInfo.TargetAddress)
 	if err != nil {
@@ -208,7 +288,11 @@ func (app *App) WithdrawReconciliationBalances(ctx types.Context, networkInfo *N
 		return fmt.Errorf("landing address does not exist")
 	}
 
+	rules := newReconciliationEligibilityRules(networkInfo.ReconciliationInfo.Eligibility)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
 	transfers := UpgradeReconciliationTransfers{}
+	var decisions []UpgradeReconciliationDecision
 
 	for _, record := range records {
 		recordAddr, err := types.AccAddressFromBech32(record[2])
@@ -216,15 +300,60 @@ func (app *App) WithdrawReconciliationBalances(ctx types.Context, networkInfo *N
 			return err
 		}
 
+		addDecision := func(reason string, balance types.Coins, swept types.Coins) {
+			decision := UpgradeReconciliationDecision{
+				EthAddr:  record[0],
+				From:     record[2],
+				Decision: ReconciliationDecisionSkipped,
+				Reason:   reason,
+				Balance:  balance,
+				Swept:    swept,
+			}
+			if !swept.IsZero() {
+				decision.Decision = ReconciliationDecisionPartial
+			}
+			decisions = append(decisions, decision)
+		}
+
 		if !app.AccountKeeper.HasAccount(ctx, recordAddr) {
+			addDecision("account does not exist", nil, nil)
 			continue
 		}
 
 		recordAccount := app.AccountKeeper.GetAccount(ctx, recordAddr)
 		recordBalanceCoins := app.BankKeeper.GetAllBalances(ctx, recordAddr)
-		if !recordBalanceCoins.IsAllPositive() || recordAccount.GetSequence() != 0 {
+		if !recordBalanceCoins.IsAllPositive() {
+			addDecision("account has no balance", recordBalanceCoins, nil)
 			continue
 		}
+		if recordAccount.GetSequence() != 0 {
+			addDecision(fmt.Sprintf("account has already signed transactions, sequence %d", recordAccount.GetSequence()), recordBalanceCoins, nil)
+			continue
+		}
+
+		sweptCoins, reason, err := rules.sweepAmount(record, recordBalanceCoins, bondDenom)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			addDecision(reason, recordBalanceCoins, sweptCoins)
+		}
+		if sweptCoins.IsZero() {
+			continue
+		}
+		recordBalanceCoins = sweptCoins
+
+		err = registerLedgerEntry(manifest, UpgradeLedgerEntry{
+			Domain: LedgerDomainDestinationBank,
+			Debit:  recordAddr.String(),
+			Credit: landingAddr.String(),
+			Amount: recordBalanceCoins,
+			Origin: record[0],
+			Memo:   "reconciliation_withdrawal",
+		})
+		if err != nil {
+			return err
+		}
 
 		err = app.BankKeeper.SendCoins(ctx, recordAddr, landingAddr, recordBalanceCoins)
 		if err != nil {
@@ -241,6 +370,14 @@ func (app *App) WithdrawReconciliationBalances(ctx types.Context, networkInfo *N
 		transfers.NumberOfTransfers = len(transfers.Transfers)
 	}
 
+	if len(decisions) > 0 {
+		if manifest.Reconciliation == nil {
+			manifest.Reconciliation = &UpgradeReconciliation{}
+		}
+		manifest.Reconciliation.Decisions = decisions
+		manifest.Reconciliation.NumberOfDecisions = len(decisions)
+	}
+
 	if transfers.NumberOfTransfers > 0 {
 		transfers.To = networkInfo.ReconciliationInfo.TargetAddress
 
@@ -270,7 +407,12 @@ func (app *App) ReplaceReconciliationContractState(ctx types.Context, networkInf
 	manifest.Reconciliation.ContractState = nil
 	contractState := UpgradeReconciliationContractState{}
 
-	for _, transfer := range manifest.Reconciliation.Transfers.Transfers {
+	var transfers []UpgradeReconciliationTransfer
+	if manifest.Reconciliation.Transfers != nil {
+		transfers = manifest.Reconciliation.Transfers.Transfers
+	}
+
+	for _, transfer := range transfers {
 		key, value := reconciliationContractStateBalancesRecord(transfer.EthAddr, transfer.Amount)
 		if key == nil {
 			continue
@@ -415,7 +557,7 @@ func (app *App) UpgradeContractAdmin(ctx types.Context, contractAddr *string, ne
 		manifest.Contracts = new(Contracts)
 	}
 
-	manifest.Contracts.AdminUpdated = append(manifest.Contracts.AdminUpdated, ContractValueUpdate{*contractAddr, oldAdmin, *newAdmin})
+	manifest.Contracts.AdminUpdated = append(manifest.Contracts.AdminUpdated, ContractValueUpdate{Address: *contractAddr, From: oldAdmin, To: *newAdmin})
 
 	return nil
 }
@@ -432,7 +574,7 @@ func (app *App) UpgradeContractAdmins(ctx types.Context, networkInfo *NetworkCon
 		}
 	}
 
-	return nil
+	return app.RotateContractAdmins(ctx, networkInfo, manifest)
 }
 
 func (app *App) DeleteContractState(ctx types.Context, contractAddr string, manifest *UpgradeManifest) error {
@@ -462,13 +604,15 @@ func (app *App) DeleteContractState(ctx types.Context, contractAddr string, mani
 }
 
 func (app *App) DeleteContractStates(ctx types.Context, networkInfo *NetworkConfig, manifest *UpgradeManifest) error {
+	err := ValidateContractStatePruning(networkInfo.Contracts)
+	if err != nil {
+		return err
+	}
+
 	var contractsToWipe []string
 
 	contractsToWipe = networkInfo.Contracts.Reconciliation.GetContracts(contractsToWipe)
 
-	contractsToWipe = networkInfo.Contracts.Almanac.GetContracts(contractsToWipe)
-	contractsToWipe = networkInfo.Contracts.AName.GetContracts(contractsToWipe)
-
 	for _, contract := range contractsToWipe {
 		err := app.DeleteContractState(ctx, contract, manifest)
 		if err != nil {
@@ -476,6 +620,13 @@ func (app *App) DeleteContractStates(ctx types.Context, networkInfo *NetworkConf
 		}
 	}
 
+	for _, contract := range []*ProdDevContract{networkInfo.Contracts.Almanac, networkInfo.Contracts.AName} {
+		err := app.PruneProdDevContractStates(ctx, contract, manifest)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/btcsuite/btcd/btcec"
+	"strings"
+)
+
+// ReconciliationClaimMsg is execute message of the reconciliation contract which withdraws reconciled balance of the Ethereum address
+type ReconciliationClaimMsg struct {
+	Reconcile ReconciliationClaim `json:"reconcile"`
+}
+
+type ReconciliationClaim struct {
+	EthAddress string `json:"eth_address"`
+	Message    string `json:"message"`
+	Signature  string `json:"signature"`
+}
+
+// ParseEthPrivateKey parses hex-encoded secp256k1 private key and returns it together with its checksummed Ethereum address
+func ParseEthPrivateKey(privKeyHex string) (*btcec.PrivateKey, string, error) {
+	privKeyBytes, err := hex.DecodeString(DropHexPrefix(strings.TrimSpace(privKeyHex)))
+	if err != nil {
+		return nil, "", fmt.Errorf("private key is not valid hex: %w", err)
+	}
+	if len(privKeyBytes) != btcec.PrivKeyBytesLen {
+		return nil, "", fmt.Errorf("private key has unexpected length %d bytes", len(privKeyBytes))
+	}
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), privKeyBytes)
+	ethAddr := ethChecksumAddress(keccak256(pubKey.SerializeUncompressed()[1:])[12:])
+	return privKey, ethAddr, nil
+}
+
+// ReconciliationClaimMessage returns message which proves ownership of the Ethereum address and binds the claim to the recipient, contract and chain
+func ReconciliationClaimMessage(ethAddr string, recipientAddr string, contractAddr string, chainID string) string {
+	return fmt.Sprintf("Reconciliation claim\nEthereum address: %s\nRecipient: %s\nContract: %s\nChain ID: %s", ethAddr, recipientAddr, contractAddr, chainID)
+}
+
+// eip191Hash returns keccak256 hash of the message prefixed as defined by EIP-191 (version 0x45, personal_sign)
+func eip191Hash(message []byte) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))
+	return keccak256(append([]byte(prefix), message...))
+}
+
+// SignEIP191 signs the message as personal_sign does and returns 65 bytes signature in r || s || v format, where v is 27 or 28
+func SignEIP191(privKey *btcec.PrivateKey, message []byte) ([]byte, error) {
+	compactSig, err := btcec.SignCompact(btcec.S256(), privKey, eip191Hash(message), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compact signature is v || r || s
+	return append(compactSig[1:], compactSig[0]), nil
+}
+
+// RecoverEIP191Signer returns checksummed Ethereum address which signed the message
+func RecoverEIP191Signer(message []byte, signature []byte) (string, error) {
+	if len(signature) != 65 {
+		return "", fmt.Errorf("signature has unexpected length %d bytes", len(signature))
+	}
+
+	compactSig := append([]byte{signature[64]}, signature[:64]...)
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compactSig, eip191Hash(message))
+	if err != nil {
+		return "", err
+	}
+	return ethChecksumAddress(keccak256(pubKey.SerializeUncompressed()[1:])[12:]), nil
+}
+
+// NewReconciliationClaimMsg creates signed claim message for the reconciliation contract
+func NewReconciliationClaimMsg(privKey *btcec.PrivateKey, ethAddr string, recipientAddr string, contractAddr string, chainID string) (*ReconciliationClaimMsg, error) {
+	message := ReconciliationClaimMessage(ethAddr, recipientAddr, contractAddr, chainID)
+	signature, err := SignEIP191(privKey, []byte(message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign claim message: %w", err)
+	}
+
+	signer, err := RecoverEIP191Signer([]byte(message), signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify claim signature: %w", err)
+	}
+	if !strings.EqualFold(signer, ethAddr) {
+		return nil, fmt.Errorf("claim signature recovers to %s instead of %s", signer, ethAddr)
+	}
+
+	return &ReconciliationClaimMsg{
+		Reconcile: ReconciliationClaim{
+			EthAddress: ethAddr,
+			Message:    message,
+			Signature:  "0x" + hex.EncodeToString(signature),
+		},
+	}, nil
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"fmt"
+	"github.com/cosmos/cosmos-sdk/types"
+	"strings"
+)
+
+const (
+	ReconciliationDecisionSkipped = "skipped"
+	ReconciliationDecisionPartial = "partial"
+)
+
+// ReconciliationEligibility configures which balances of reconciliation addresses are swept to the landing address
+type ReconciliationEligibility struct {
+	CapAtRecordedAmount bool     `json:"cap_at_recorded_amount,omitempty"` // Sweep at most total amount recorded in reconciliation data, the cap applies to bond denom only and balances in other denoms are then not swept at all
+	ExcludedAddresses   []string `json:"excluded_addresses,omitempty"`     // Ethereum or fetch addresses which are never swept
+	ActiveAddresses     []string `json:"active_addresses,omitempty"`       // Fetch addresses which show user activity, e.g. incoming transfers, prepared off-chain from tx index and never swept
+}
+
+type reconciliationEligibilityRules struct {
+	capAtRecordedAmount bool
+	excluded            *OrderedMap[string, bool]
+	active              *OrderedMap[string, bool]
+}
+
+func normaliseReconciliationAddress(addr string) string {
+	if strings.HasPrefix(strings.ToLower(addr), "0x") {
+		return strings.ToLower(addr)
+	}
+	return addr
+}
+
+func newReconciliationEligibilityRules(eligibility *ReconciliationEligibility) *reconciliationEligibilityRules {
+	rules := &reconciliationEligibilityRules{
+		excluded: NewOrderedSet[string](nil),
+		active:   NewOrderedSet[string](nil),
+	}
+	if eligibility == nil {
+		return rules
+	}
+
+	rules.capAtRecordedAmount = eligibility.CapAtRecordedAmount
+	for _, addr := range eligibility.ExcludedAddresses {
+		rules.excluded.Set(normaliseReconciliationAddress(addr), true)
+	}
+	for _, addr := range eligibility.ActiveAddresses {
+		rules.active.Set(addr, true)
+	}
+	return rules
+}
+
+// Validate checks addresses of eligibility config
+func (eligibility *ReconciliationEligibility) Validate(addrPrefix string) error {
+	if eligibility == nil {
+		return nil
+	}
+
+	for _, addr := range eligibility.ExcludedAddresses {
+		if strings.HasPrefix(strings.ToLower(addr), "0x") {
+			if _, err := parseEthAddress(addr); err != nil {
+				return fmt.Errorf("reconciliation eligibility: excluded address: %w", err)
+			}
+			continue
+		}
+		if err := verifyAddress(addr, &addrPrefix); err != nil {
+			return fmt.Errorf("reconciliation eligibility: excluded address: %w", err)
+		}
+	}
+
+	for _, addr := range eligibility.ActiveAddresses {
+		if err := verifyAddress(addr, &addrPrefix); err != nil {
+			return fmt.Errorf("reconciliation eligibility: active address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// sweepAmount returns part of the balance which is eligible to be swept, empty reason means that the whole balance is eligible
+func (rules *reconciliationEligibilityRules) sweepAmount(record []string, balance types.Coins, bondDenom string) (types.Coins, string, error) {
+	ethAddr := record[reconciliationColumnEthAddr]
+	fetchAddr := record[reconciliationColumnFetchAddr]
+
+	if rules.excluded.Has(normaliseReconciliationAddress(ethAddr)) || rules.excluded.Has(fetchAddr) {
+		return nil, "address is excluded", nil
+	}
+
+	if rules.active.Has(fetchAddr) {
+		return nil, "address shows user activity", nil
+	}
+
+	if !rules.capAtRecordedAmount {
+		return balance, "", nil
+	}
+
+	recorded, err := parseReconciliationAmount(record[reconciliationColumnTotal])
+	if err != nil {
+		return nil, "", err
+	}
+	if recorded == nil {
+		return nil, "no amount is recorded in reconciliation data", nil
+	}
+
+	capAmount := types.MinInt(balance.AmountOf(bondDenom), recorded.TruncateInt())
+	if !capAmount.IsPositive() {
+		return nil, fmt.Sprintf("no %s balance to sweep", bondDenom), nil
+	}
+
+	swept := types.NewCoins(types.NewCoin(bondDenom, capAmount))
+	if isEqualCoins(swept, balance) {
+		return swept, "", nil
+	}
+	return swept, fmt.Sprintf("sweep is capped at recorded amount %s%s", recorded.TruncateInt().String(), bondDenom), nil
+}
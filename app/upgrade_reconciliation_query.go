@@ -0,0 +1,70 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+func parseEthAddress(ethAddrHex string) ([]byte, error) {
+	ethAddrRaw, err := hex.DecodeString(DropHexPrefix(ethAddrHex))
+	if err != nil {
+		return nil, fmt.Errorf("eth address %s is not valid hex: %w", ethAddrHex, err)
+	}
+	if len(ethAddrRaw) != 20 {
+		return nil, fmt.Errorf("eth address %s has unexpected length %d bytes", ethAddrHex, len(ethAddrRaw))
+	}
+	return ethAddrRaw, nil
+}
+
+// ReconciliationBalanceKey returns raw storage key of the balance record of the Ethereum address in the reconciliation contract state
+func ReconciliationBalanceKey(ethAddrHex string) ([]byte, error) {
+	ethAddrRaw, err := parseEthAddress(ethAddrHex)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, reconciliationBalancesKey...), ethAddrRaw...), nil
+}
+
+// ParseReconciliationBalance decodes value of the balance record, missing record means zero balance
+func ParseReconciliationBalance(value []byte) (types.Coins, error) {
+	if len(value) == 0 {
+		return types.NewCoins(), nil
+	}
+	var coins types.Coins
+	if err := json.Unmarshal(value, &coins); err != nil {
+		return nil, fmt.Errorf("failed to decode balance record: %w", err)
+	}
+	return coins, nil
+}
+
+// ReconciliationStateKey returns raw storage key of the state record of the reconciliation contract
+func ReconciliationStateKey() []byte {
+	return append([]byte{}, reconciliationStateKey...)
+}
+
+// ParseReconciliationState decodes value of the state record of the reconciliation contract
+func ParseReconciliationState(value []byte) (*ReconciliationContractStateRecord, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("state record is missing")
+	}
+	var state ReconciliationContractStateRecord
+	if err := json.Unmarshal(value, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode state record: %w", err)
+	}
+	return &state, nil
+}
+
+// GetReconciliationContractAddr returns address of the reconciliation contract from the built-in network config of the chain
+func GetReconciliationContractAddr(chainID string) (string, bool) {
+	networkInfo, _, err := LoadBuiltinNetworkConfig(chainID)
+	if err != nil || networkInfo.Contracts == nil {
+		return "", false
+	}
+	contractAddr := networkInfo.Contracts.Reconciliation.GetPrimaryContractAddr()
+	if contractAddr == nil {
+		return "", false
+	}
+	return *contractAddr, true
+}
@@ -0,0 +1,187 @@
+package app
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"golang.org/x/crypto/sha3"
+	"strings"
+)
+
+const (
+	reconciliationColumnEthAddr = iota
+	reconciliationColumnPubKey
+	reconciliationColumnFetchAddr
+	reconciliationColumnTotal
+	reconciliationColumnStaked
+	reconciliationColumnUnstaked
+
+	reconciliationNumberOfColumns
+)
+
+// Amounts in reconciliation data were exported with float64 precision, so they carry only ~17 significant digits
+var reconciliationAmountRelativeTolerance = types.NewDecWithPrec(1, 15)
+
+type ReconciliationRecordIssue struct {
+	Row       int    `json:"row"`
+	EthAddr   string `json:"eth_addr"`
+	FetchAddr string `json:"fetch_addr"`
+	Reason    string `json:"reason"`
+}
+
+func keccak256(data []byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// ethChecksumAddress returns EIP-55 mixed-case representation of the raw address
+func ethChecksumAddress(addr []byte) string {
+	lowerHex := hex.EncodeToString(addr)
+	hash := hex.EncodeToString(keccak256([]byte(lowerHex)))
+
+	res := []byte(lowerHex)
+	for i, c := range res {
+		if c >= 'a' && c <= 'f' && hash[i] >= '8' {
+			res[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(res)
+}
+
+// parseUncompressedSecp256k1PubKey accepts hex of uncompressed public key with or without 0x04 prefix
+func parseUncompressedSecp256k1PubKey(pubKeyHex string) (*btcec.PublicKey, error) {
+	pubKeyBytes, err := hex.DecodeString(DropHexPrefix(pubKeyHex))
+	if err != nil {
+		return nil, fmt.Errorf("pubkey is not valid hex: %w", err)
+	}
+
+	switch len(pubKeyBytes) {
+	case 64:
+		pubKeyBytes = append([]byte{0x04}, pubKeyBytes...)
+	case 65:
+		if pubKeyBytes[0] != 0x04 {
+			return nil, fmt.Errorf("pubkey has unexpected prefix 0x%02x", pubKeyBytes[0])
+		}
+	default:
+		return nil, fmt.Errorf("pubkey has unexpected length %d bytes", len(pubKeyBytes))
+	}
+
+	return btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+}
+
+// DeriveReconciliationAddresses derives Ethereum and fetch addresses from uncompressed secp256k1 public key
+func DeriveReconciliationAddresses(pubKeyHex string, addrPrefix string) (string, string, error) {
+	pubKey, err := parseUncompressedSecp256k1PubKey(pubKeyHex)
+	if err != nil {
+		return "", "", err
+	}
+
+	ethAddr := ethChecksumAddress(keccak256(pubKey.SerializeUncompressed()[1:])[12:])
+
+	cosmosPubKey := secp256k1.PubKey{Key: pubKey.SerializeCompressed()}
+	fetchAddr, err := bech32.ConvertAndEncode(addrPrefix, cosmosPubKey.Address())
+	if err != nil {
+		return "", "", err
+	}
+
+	return ethAddr, fetchAddr, nil
+}
+
+func parseReconciliationAmount(val string) (*types.Dec, error) {
+	if val == "" {
+		return nil, nil
+	}
+	amount, err := types.NewDecFromStr(val)
+	if err != nil {
+		return nil, err
+	}
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("amount %s is negative", val)
+	}
+	return &amount, nil
+}
+
+func validateReconciliationAmounts(record []string) error {
+	var amounts []*types.Dec
+	for _, column := range []int{reconciliationColumnTotal, reconciliationColumnStaked, reconciliationColumnUnstaked} {
+		amount, err := parseReconciliationAmount(record[column])
+		if err != nil {
+			return fmt.Errorf("invalid amount in column %d: %w", column, err)
+		}
+		amounts = append(amounts, amount)
+	}
+	total, staked, unstaked := amounts[0], amounts[1], amounts[2]
+
+	// Amount columns are either all empty or all set
+	if total == nil && staked == nil && unstaked == nil {
+		return nil
+	}
+	if total == nil || staked == nil || unstaked == nil {
+		return fmt.Errorf("amount columns are only partially set")
+	}
+
+	sum := staked.Add(*unstaked)
+	if total.Sub(sum).Abs().GT(types.MaxDec(*total, sum).Mul(reconciliationAmountRelativeTolerance)) {
+		return fmt.Errorf("total amount %s is not equal to staked %s + unstaked %s", total.String(), staked.String(), unstaked.String())
+	}
+	return nil
+}
+
+// ValidateReconciliationRecord checks that addresses in the record are derived from its public key and that the amounts add up
+func ValidateReconciliationRecord(record []string, addrPrefix string) error {
+	if len(record) != reconciliationNumberOfColumns {
+		return fmt.Errorf("expected %d columns, got %d", reconciliationNumberOfColumns, len(record))
+	}
+
+	ethAddr := record[reconciliationColumnEthAddr]
+	fetchAddr := record[reconciliationColumnFetchAddr]
+
+	derivedEthAddr, derivedFetchAddr, err := DeriveReconciliationAddresses(record[reconciliationColumnPubKey], addrPrefix)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(ethAddr, derivedEthAddr) {
+		return fmt.Errorf("eth address %s does not match address %s derived from pubkey", ethAddr, derivedEthAddr)
+	}
+
+	// Mixed-case addresses must carry valid EIP-55 checksum
+	ethAddrNoPrefix := DropHexPrefix(ethAddr)
+	if ethAddrNoPrefix != strings.ToLower(ethAddrNoPrefix) && ethAddrNoPrefix != strings.ToUpper(ethAddrNoPrefix) && ethAddrNoPrefix != DropHexPrefix(derivedEthAddr) {
+		return fmt.Errorf("eth address %s has invalid checksum, expected %s", ethAddr, derivedEthAddr)
+	}
+
+	if fetchAddr != derivedFetchAddr {
+		return fmt.Errorf("fetch address %s does not match address %s derived from pubkey", fetchAddr, derivedFetchAddr)
+	}
+
+	return validateReconciliationAmounts(record)
+}
+
+// ValidateReconciliationRecords returns issues of all inconsistent records, rows are numbered from 1
+func ValidateReconciliationRecords(records [][]string, addrPrefix string) []ReconciliationRecordIssue {
+	var issues []ReconciliationRecordIssue
+
+	for i, record := range records {
+		err := ValidateReconciliationRecord(record, addrPrefix)
+		if err == nil {
+			continue
+		}
+
+		issue := ReconciliationRecordIssue{
+			Row:    i + 1,
+			Reason: err.Error(),
+		}
+		if len(record) > reconciliationColumnFetchAddr {
+			issue.EthAddr = record[reconciliationColumnEthAddr]
+			issue.FetchAddr = record[reconciliationColumnFetchAddr]
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fetchai/fetchd/app"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	dbm "github.com/tendermint/tm-db"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	FlagReplaceContractState = "replace"
+)
+
+func utilContractCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "contract",
+		Short:                      "Contract storage commands working directly with the node application DB",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	AddCommandDumpContractState(cmd)
+	AddCommandLoadContractState(cmd)
+
+	return cmd
+}
+
+// openApplicationDB opens application DB of the node, goleveldb backend is opened read-only if requested
+func openApplicationDB(home string, readOnly bool) (dbm.DB, error) {
+	dataDir := filepath.Join(home, "data")
+	if readOnly && (sdk.DBBackend == "" || sdk.DBBackend == string(dbm.GoLevelDBBackend)) {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	return sdk.NewLevelDB("application", dataDir)
+}
+
+func AddCommandDumpContractState(contractCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "dump-state [contract_address]",
+		Short: "Dumps raw storage of the contract from the node application DB as JSON lines",
+		Long: `This command opens the node application DB read-only at the latest height and writes every KV pair of the contract storage as one JSON object per line.
+Keys are hex encoded. Namespaces of cw-storage-plus Items and Maps are decoded from keys where possible, values which are valid JSON are written as is, other values are hex encoded.
+Output can be edited and written back with the load-state command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			db, err := openApplicationDB(serverCtx.Config.RootDir, true)
+			if err != nil {
+				return fmt.Errorf("failed to open application DB: %w", err)
+			}
+			defer db.Close()
+
+			cms := rootmulti.NewStore(db, serverCtx.Logger)
+			cms.SetIAVLDisableFastNode(true)
+			wasmKey := sdk.NewKVStoreKey(wasmtypes.StoreKey)
+			cms.MountStoreWithDB(wasmKey, sdk.StoreTypeIAVL, nil)
+			if err = cms.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to load latest version: %w", err)
+			}
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			numberOfRecords := 0
+			err = app.IterateContractState(cms.GetKVStore(wasmKey), args[0], func(key []byte, value []byte) error {
+				numberOfRecords++
+				return encoder.Encode(app.NewContractStateRecord(key, value))
+			})
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.ErrOrStderr(), "Dumped %d records of contract %s at height %d\n", numberOfRecords, args[0], cms.LastCommitID().Version)
+			return err
+		},
+	}
+
+	contractCmd.AddCommand(cmd)
+}
+
+func readContractStateRecords(filePath string) ([]app.ContractStateRecord, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+	defer file.Close()
+
+	var records []app.ContractStateRecord
+	decoder := json.NewDecoder(file)
+	for {
+		var record app.ContractStateRecord
+		err = decoder.Decode(&record)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error parsing record %d: %w", len(records), err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func AddCommandLoadContractState(contractCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "load-state [contract_address] [records_jsonl_file]",
+		Short: "Writes contract storage records to the node application DB at the latest height",
+		Long: `This command writes records in the dump-state format to the contract storage and re-commits the latest height in place, so the node stays at the same height as its block store.
+Records are written by their hex encoded key, records with "delete": true remove the key. With --replace flag the whole contract storage is removed first.
+The node must be stopped. The resulting app hash differs from the one agreed by the network, so the node can only continue as a local fork.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			replace, err := cmd.Flags().GetBool(FlagReplaceContractState)
+			if err != nil {
+				return err
+			}
+
+			records, err := readContractStateRecords(args[1])
+			if err != nil {
+				return err
+			}
+
+			db, err := openApplicationDB(serverCtx.Config.RootDir, false)
+			if err != nil {
+				return fmt.Errorf("failed to open application DB: %w", err)
+			}
+			defer db.Close()
+
+			appCreator := appCreator{app.MakeEncodingConfig()}
+			fetchApp, isFetchApp := appCreator.newApp(serverCtx.Logger, db, nil, serverCtx.Viper).(*app.App)
+			if !isFetchApp {
+				return fmt.Errorf("unexpected application type")
+			}
+
+			height, err := fetchApp.OverwriteContractStateAtLatestHeight(args[0], records, replace)
+			if err != nil {
+				return err
+			}
+
+			return client.GetClientContextFromCmd(cmd).PrintString(fmt.Sprintf("Written %d records to contract %s at height %d\n", len(records), args[0], height))
+		},
+	}
+
+	cmd.Flags().Bool(FlagReplaceContractState, false, "Remove whole contract storage before the records are written")
+
+	contractCmd.AddCommand(cmd)
+}
@@ -21,7 +21,14 @@ const (
 	FlagCudosMigrationConfigPath   = "cudos-migration-config-path"
 	FlagCudosMigrationConfigSha256 = "cudos-migration-config-sha256"
 
+	FlagCudosReconciliationDataPath   = "cudos-reconciliation-data-path"
+	FlagCudosReconciliationDataSha256 = "cudos-reconciliation-data-sha256"
+
 	FlagManifestDestinationPath = "manifest-destination-path"
+	FlagDestinationDenom        = "destination-denom"
+	FlagDestinationGenesisPath  = "destination-genesis-path"
+
+	DefaultDestinationDenom = "afet"
 )
 
 func AddCudosFlags(startCmd *cobra.Command) {
@@ -29,6 +36,8 @@ func AddCudosFlags(startCmd *cobra.Command) {
 	startCmd.Flags().String(FlagCudosMigrationConfigPath, "", "Upgrade config file path. Required to be provided *exclusively* during cudos migration upgrade node start, *ignored* on all subsequent node starts.")
 	startCmd.Flags().String(FlagCudosGenesisSha256, "", "Sha256 of the cudos genesis file. Optional to be provided *exclusively* during cudos migration upgrade node start, *ignored* on all subsequent node starts.")
 	startCmd.Flags().String(FlagCudosMigrationConfigSha256, "", fmt.Sprintf("Sha256 of the upgrade config file. Required if to be provided *exclusively* during cudos migration upgrade node start and *only IF* \"%v\" flag has been provided, *ignored* on all subsequent node starts.", FlagCudosMigrationConfigPath))
+	startCmd.Flags().String(FlagCudosReconciliationDataPath, "", "Reconciliation data CSV file path, replaces reconciliation data embedded in the binary. Optional to be provided *exclusively* during cudos migration upgrade node start, *ignored* on all subsequent node starts.")
+	startCmd.Flags().String(FlagCudosReconciliationDataSha256, "", fmt.Sprintf("Sha256 of the reconciliation data CSV file. Required *only IF* \"%v\" flag has been provided, *ignored* on all subsequent node starts.", FlagCudosReconciliationDataPath))
 
 	// Capture the existing PreRunE function
 	existingPreRunE := startCmd.PreRunE
@@ -75,8 +84,28 @@ It checks whether the network merge config file conforms to expected schema - pr
 				return err
 			}
 
+			destinationDenom, err := cmd.Flags().GetString(FlagDestinationDenom)
+			if err != nil {
+				return err
+			}
+
+			destinationGenesisPath, err := cmd.Flags().GetString(FlagDestinationGenesisPath)
+			if err != nil {
+				return err
+			}
+
+			reconciliationDataPath, err := cmd.Flags().GetString(FlagCudosReconciliationDataPath)
+			if err != nil {
+				return err
+			}
+
+			reconciliationDataSha256, err := cmd.Flags().GetString(FlagCudosReconciliationDataSha256)
+			if err != nil {
+				return err
+			}
+
 			// Read and verify the JSON file
-			if err = VerifyConfigFile(configFilePath, GenesisFilePath, ctx, manifestFilePath); err != nil {
+			if err = VerifyConfigFile(configFilePath, GenesisFilePath, ctx, manifestFilePath, destinationDenom, destinationGenesisPath, reconciliationDataPath, reconciliationDataSha256); err != nil {
 				return err
 			}
 
@@ -84,11 +113,52 @@ It checks whether the network merge config file conforms to expected schema - pr
 		},
 	}
 	cmd.Flags().String(FlagManifestDestinationPath, "", "Save manifest to specified file if set")
+	cmd.Flags().String(FlagDestinationDenom, DefaultDestinationDenom, "Denomination of the destination chain used for expected supply breakdown")
+	cmd.Flags().String(FlagDestinationGenesisPath, "", "Cross-check the config against destination chain state exported by `fetchd export` if set")
+	cmd.Flags().String(FlagCudosReconciliationDataPath, "", "Verify reconciliation data CSV file which will be given to the node at the upgrade instead of embedded data if set")
+	cmd.Flags().String(FlagCudosReconciliationDataSha256, "", "Sha256 of the reconciliation data CSV file")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	networkMergeCmd.AddCommand(cmd)
 }
 
+func AddCommandInitConfig(networkMergeCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "init-config [source_chain_genesis_json_file_path]",
+		Short: "Drafts network merge config JSON from the source chain genesis",
+		Long: `This command writes a draft of the network merge config JSON to stdout, derived from the source chain genesis.
+Total supply is taken from the genesis, validators map lists every source validator ordered by stake, and all module and contract accounts are listed as not delegated account candidates.
+Values which can not be derived from the genesis are set to placeholders starting with "` + app.NetworkConfigPlaceholderPrefix + `" which describe the expected value. verify-config refuses the config until all placeholders are replaced, and conversion constants and supply to mint are set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, genDoc, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var jsonData map[string]interface{}
+			if err = json.Unmarshal(genDoc.AppState, &jsonData); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			networkConfig, err := app.NewNetworkConfigScaffold(jsonData, genDoc)
+			if err != nil {
+				return err
+			}
+
+			configJSON, err := json.MarshalIndent(networkConfig, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = cmd.OutOrStdout().Write(append(configJSON, '\n'))
+			return err
+		},
+	}
+
+	networkMergeCmd.AddCommand(cmd)
+}
+
 func AddCommandExtractAddressInfo(networkMergeCmd *cobra.Command) {
 	cmd := &cobra.Command{
 		Use:   "extract-address-info [network_merge_config_json_file_path] [source_chain_genesis_json_file_path] [address]",
@@ -115,6 +185,40 @@ It utilizes the provided network merge config and genesis JSON files to perform
 	networkMergeCmd.AddCommand(cmd)
 }
 
+func AddCommandShowBuiltinConfig(networkMergeCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "show-builtin-config [chain_id]",
+		Short: "Prints network merge config embedded in the binary for the chain",
+		Long: `This command prints the built-in network merge config used by the upgrade when no config file is given, exactly as it is embedded in the binary.
+The config is written to stdout and its sha256, verified against the built-in registry, to stderr, so the output can be saved and audited with standard tools.
+Without chain id, all registered chain ids are listed together with sha256 of their configs.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				for _, chainID := range app.BuiltinNetworkConfigChainIDs() {
+					if _, err := fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", app.BuiltinNetworkConfigs[chainID], chainID); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
+			data, sha256Hex, err := app.GetBuiltinNetworkConfigData(args[0])
+			if err != nil {
+				return err
+			}
+
+			if _, err = cmd.OutOrStdout().Write(data); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(cmd.ErrOrStderr(), "sha256: %s\n", sha256Hex)
+			return err
+		},
+	}
+
+	networkMergeCmd.AddCommand(cmd)
+}
+
 func AddCommandManifestAddressInfo(networkMergeCmd *cobra.Command) {
 	cmd := &cobra.Command{
 		Use:   "manifest-address-info [manifest_file_path] [address]",
@@ -149,8 +253,11 @@ func utilNetworkMergeCommand() *cobra.Command {
 	}
 
 	AddCommandVerify(cmd)
+	AddCommandInitConfig(cmd)
 	AddCommandExtractAddressInfo(cmd)
 	AddCommandManifestAddressInfo(cmd)
+	AddCommandReport(cmd)
+	AddCommandShowBuiltinConfig(cmd)
 
 	return cmd
 }
@@ -175,7 +282,7 @@ func LoadGenesisDataFromFile(GenesisFilePath string, cudosConfig *app.CudosMerge
 }
 
 // VerifyConfigFile validates the content of a JSON configuration file.
-func VerifyConfigFile(configFilePath string, GenesisFilePath string, ctx client.Context, manifestFilePath string) error {
+func VerifyConfigFile(configFilePath string, GenesisFilePath string, ctx client.Context, manifestFilePath string, destinationDenom string, destinationGenesisPath string, reconciliationDataPath string, reconciliationDataSha256 string) error {
 	manifest := app.NewUpgradeManifest()
 
 	networkInfo, configBytes, err := app.LoadNetworkConfigFromFile(configFilePath)
@@ -189,6 +296,11 @@ func VerifyConfigFile(configFilePath string, GenesisFilePath string, ctx client.
 	}
 	manifest.NetworkConfigFileSha256 = configHashHex
 
+	err = app.VerifyNoPlaceholders(networkInfo)
+	if err != nil {
+		return err
+	}
+
 	genesisHashHex, err := app.GenerateSHA256FromFile(GenesisFilePath)
 	if err != nil {
 		return err
@@ -216,6 +328,55 @@ func VerifyConfigFile(configFilePath string, GenesisFilePath string, ctx client.
 		return err
 	}
 
+	if reconciliationDataPath != "" {
+		if networkInfo.ReconciliationInfo == nil {
+			return fmt.Errorf("reconciliation data file is given, but network config has no reconciliation info")
+		}
+		err = networkInfo.ReconciliationInfo.SetInputCSVFile(reconciliationDataPath, reconciliationDataSha256)
+		if err != nil {
+			return err
+		}
+	}
+
+	if networkInfo.ReconciliationInfo != nil {
+		issues, err := networkInfo.ReconciliationInfo.VerifyRecords(app.AccountAddressPrefix)
+		if err != nil {
+			return err
+		}
+		if len(issues) > 0 {
+			err = ctx.PrintString(fmt.Sprintf("%d inconsistent reconciliation records will be skipped\n", len(issues)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if networkInfo.Contracts != nil {
+		err = app.ValidateContractStatePruning(networkInfo.Contracts)
+		if err != nil {
+			return err
+		}
+		err = app.ValidateContractOperations(networkInfo.Contracts.Operations)
+		if err != nil {
+			return err
+		}
+		err = app.ValidateContractMigrations(networkInfo.Contracts.Migrations)
+		if err != nil {
+			return err
+		}
+		err = app.ValidateContractAdminRotations(networkInfo.Contracts.AdminRotations)
+		if err != nil {
+			return err
+		}
+	}
+
+	if destinationGenesisPath != "" {
+		err = VerifyConfigAgainstDestinationGenesis(ctx, networkInfo, cudosConfig, destinationGenesisPath)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Verify extra supply
 	bondDenomSourceTotalSupply := genesisData.TotalSupply.AmountOf(genesisData.BondDenom)
 	if cudosConfig.Config.TotalCudosSupply.LT(bondDenomSourceTotalSupply) {
@@ -228,6 +389,16 @@ func VerifyConfigFile(configFilePath string, GenesisFilePath string, ctx client.
 		return err
 	}
 
+	err = app.WriteExpectedDestinationSupplyToManifest(genesisData, cudosConfig, destinationDenom, manifest)
+	if err != nil {
+		return err
+	}
+
+	err = PrintSupplyBreakdown(ctx, manifest.SupplyBreakdown)
+	if err != nil {
+		return err
+	}
+
 	if manifestFilePath != "" {
 		err = app.SaveManifestToPath(manifest, manifestFilePath)
 		if err != nil {
@@ -239,6 +410,37 @@ func VerifyConfigFile(configFilePath string, GenesisFilePath string, ctx client.
 	return nil
 }
 
+// VerifyConfigAgainstDestinationGenesis cross-checks the config against destination chain state and prints admins of the contracts it refers to
+func VerifyConfigAgainstDestinationGenesis(ctx client.Context, networkInfo *app.NetworkConfig, cudosConfig *app.CudosMergeConfig, destinationGenesisPath string) error {
+	destinationState, err := app.LoadDestinationStateFromFile(destinationGenesisPath, app.MakeEncodingConfig().Marshaler)
+	if err != nil {
+		return err
+	}
+
+	admins, err := app.VerifyConfigAgainstDestinationState(networkInfo, cudosConfig, destinationState)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.PrintString(fmt.Sprintf("Config matches destination state of %s at height %d\n", destinationState.ChainID, destinationState.BlockHeight))
+	if err != nil {
+		return err
+	}
+
+	for _, admin := range admins {
+		newAdmin := "unchanged"
+		if admin.NewAdmin != nil {
+			newAdmin = fmt.Sprintf("\"%s\"", *admin.NewAdmin)
+		}
+		err = ctx.PrintString(fmt.Sprintf("Contract %s admin \"%s\" -> %s\n", admin.Contract, admin.CurrentAdmin, newAdmin))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func ExtractAddressInfo(configFilePath string, GenesisFilePath string, address string, ctx client.Context) error {
 	manifest := app.NewUpgradeManifest()
 
@@ -583,3 +785,39 @@ func ManifestAddressInfo(manifestFilePath string, address string, ctx client.Con
 
 	return nil
 }
+
+func formatSupplyBreakdownValue(value *sdk.Int) string {
+	if value == nil {
+		return "-"
+	}
+	return value.String()
+}
+
+func printSupplyBreakdownLines(ctx client.Context, title string, lines []*app.UpgradeSupplyBreakdownLine) error {
+	err := ctx.PrintString(fmt.Sprintf("%s:\n%-24s %-10s %32s %32s %32s\n", title, "line", "denom", "expected", "actual", "difference"))
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		err = ctx.PrintString(fmt.Sprintf("%-24s %-10s %32s %32s %32s\n", line.Name, line.Denom, formatSupplyBreakdownValue(line.Expected), formatSupplyBreakdownValue(line.Actual), formatSupplyBreakdownValue(line.Difference)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func PrintSupplyBreakdown(ctx client.Context, breakdown *app.UpgradeSupplyBreakdown) error {
+	if breakdown == nil {
+		return nil
+	}
+
+	err := printSupplyBreakdownLines(ctx, "Source supply", breakdown.Source)
+	if err != nil {
+		return err
+	}
+
+	return printSupplyBreakdownLines(ctx, "Destination supply", breakdown.Destination)
+}
@@ -0,0 +1,795 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fetchai/fetchd/app"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagReportFormat = "format"
+	FlagReportTopN   = "top-n"
+	FlagReportOutput = "output"
+
+	ReportFormatMarkdown = "markdown"
+	ReportFormatHTML     = "html"
+
+	DefaultReportTopN = 10
+)
+
+// reportTable is rendered together with its caption, so that caption always describes the table below it
+type reportTable struct {
+	Caption string
+	Header  []string
+	Rows    [][]string
+}
+
+// reportSection renders paragraphs first, followed by the tables
+type reportSection struct {
+	Title      string
+	Paragraphs []string
+	Tables     []reportTable
+}
+
+type upgradeReport struct {
+	Title    string
+	Sections []reportSection
+}
+
+func AddCommandReport(networkMergeCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "report [manifest_file_path] [network_merge_config_json_file_path]",
+		Short: "Generates human-readable report of the network merge upgrade",
+		Long: `This command turns the upgrade manifest and the network merge config into a Markdown or HTML report.
+The report contains totals per manifest section, top-N movements, validator mapping outcomes, contract changes and balance routing, parameter changes, ledger totals, conversion tiers, rounding remainders, dust accounts, vesting schedules, gov deposit refunds, account pubkeys, supply verification, tolerance checks and the post-upgrade audit.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			manifestFilePath := args[0]
+			configFilePath := args[1]
+
+			format, err := cmd.Flags().GetString(FlagReportFormat)
+			if err != nil {
+				return err
+			}
+
+			topN, err := cmd.Flags().GetInt(FlagReportTopN)
+			if err != nil {
+				return err
+			}
+
+			outputFilePath, err := cmd.Flags().GetString(FlagReportOutput)
+			if err != nil {
+				return err
+			}
+
+			reportStr, err := GenerateUpgradeReport(manifestFilePath, configFilePath, format, topN)
+			if err != nil {
+				return err
+			}
+
+			if outputFilePath != "" {
+				return os.WriteFile(outputFilePath, []byte(reportStr), 0o644)
+			}
+
+			return ctx.PrintString(reportStr)
+		},
+	}
+
+	cmd.Flags().String(FlagReportFormat, ReportFormatMarkdown, fmt.Sprintf("Output format of the report (%s|%s)", ReportFormatMarkdown, ReportFormatHTML))
+	cmd.Flags().Int(FlagReportTopN, DefaultReportTopN, "Number of the largest movements listed in the report")
+	cmd.Flags().String(FlagReportOutput, "", "Save report to specified file if set, otherwise it is printed to stdout")
+
+	networkMergeCmd.AddCommand(cmd)
+}
+
+// GenerateUpgradeReport loads manifest and network config files and renders the report in requested format.
+func GenerateUpgradeReport(manifestFilePath string, configFilePath string, format string, topN int) (string, error) {
+	manifest, err := app.LoadManifestFromPath(manifestFilePath)
+	if err != nil {
+		return "", err
+	}
+
+	networkInfo, configBytes, err := app.LoadNetworkConfigFromFile(configFilePath)
+	if err != nil {
+		return "", err
+	}
+
+	report := buildUpgradeReport(manifest, networkInfo, app.GenerateSha256Hex(*configBytes), topN)
+
+	switch format {
+	case ReportFormatMarkdown:
+		return renderMarkdownReport(report), nil
+	case ReportFormatHTML:
+		return renderHTMLReport(report), nil
+	default:
+		return "", fmt.Errorf("unsupported report format \"%s\"", format)
+	}
+}
+
+func buildUpgradeReport(manifest *app.UpgradeManifest, networkInfo *app.NetworkConfig, configHashHex string, topN int) *upgradeReport {
+	report := upgradeReport{
+		Title: fmt.Sprintf("Network merge report: %s -> %s", manifest.MergeSourceChainID, manifest.DestinationChainID),
+	}
+
+	report.Sections = append(report.Sections,
+		reportOverviewSection(manifest, configHashHex),
+		reportTotalsSection(manifest),
+		reportTopMovementsSection(manifest, topN),
+		reportValidatorMappingSection(manifest, networkInfo),
+		reportContractsSection(manifest),
+		reportContractRoutingSection(manifest),
+		reportParamsSection(manifest),
+		reportLedgerSection(manifest),
+		reportConversionTiersSection(manifest),
+		reportRoundingSection(manifest),
+		reportDustSection(manifest, topN),
+		reportVestingSchedulesSection(manifest),
+		reportGovDepositsSection(manifest),
+		reportPubkeysSection(manifest, topN),
+		reportSupplySection(manifest),
+		reportToleranceChecksSection(manifest),
+		reportAuditSection(manifest),
+	)
+
+	return &report
+}
+
+func reportOverviewSection(manifest *app.UpgradeManifest, configHashHex string) reportSection {
+	return reportSection{
+		Title: "Overview",
+		Tables: []reportTable{{
+			Header: []string{"Property", "Value"},
+			Rows: [][]string{
+				{"Upgrade plan name", manifest.GovProposalUpgradePlanName},
+				{"Merge source chain ID", manifest.MergeSourceChainID},
+				{"Merge source block height", fmt.Sprintf("%d", manifest.SourceChainBlockHeight)},
+				{"Destination chain ID", manifest.DestinationChainID},
+				{"Destination block height", fmt.Sprintf("%d", manifest.DestinationChainBlockHeight)},
+				{"Source genesis sha256", manifest.GenesisFileSha256},
+				{"Network config sha256 (manifest)", manifest.NetworkConfigFileSha256},
+				{"Network config sha256 (report input)", configHashHex},
+			},
+		}},
+	}
+}
+
+func reportTotalsSection(manifest *app.UpgradeManifest) reportSection {
+	table := reportTable{Header: []string{"Section", "Entries", "Aggregated amount"}}
+
+	if manifest.Migration != nil {
+		table.Rows = append(table.Rows, []string{"Migrations", fmt.Sprintf("%d", manifest.Migration.NumberOfMigrations), manifest.Migration.AggregatedMigratedAmount.String()})
+	}
+	if manifest.MoveGenesisBalance != nil {
+		table.Rows = append(table.Rows, []string{"Genesis balance movements", fmt.Sprintf("%d", manifest.MoveGenesisBalance.NumberOfMovements), manifest.MoveGenesisBalance.AggregatedMovedAmount.String()})
+	}
+	if manifest.MoveMintedBalance != nil {
+		table.Rows = append(table.Rows, []string{"Minted balance movements", fmt.Sprintf("%d", len(manifest.MoveMintedBalance.Movements)), ""})
+	}
+	if manifest.IBC != nil {
+		table.Rows = append(table.Rows, []string{"IBC escrow withdrawals", fmt.Sprintf("%d", manifest.IBC.NumberOfTransfers), manifest.IBC.AggregatedTransferredAmount.String()})
+	}
+	if manifest.Delegate != nil {
+		aggregatedDelegatedAmount := ""
+		if manifest.Delegate.AggregatedDelegatedAmount != nil {
+			aggregatedDelegatedAmount = manifest.Delegate.AggregatedDelegatedAmount.String()
+		}
+		table.Rows = append(table.Rows, []string{"Created delegations", fmt.Sprintf("%d", manifest.Delegate.NumberOfDelegations), aggregatedDelegatedAmount})
+	}
+	if manifest.MoveDelegations != nil {
+		table.Rows = append(table.Rows, []string{"Delegation movements", fmt.Sprintf("%d", manifest.MoveDelegations.NumberOfMovements), ""})
+	}
+	if manifest.VestingCollision != nil {
+		table.Rows = append(table.Rows, []string{"Vesting collisions", fmt.Sprintf("%d", manifest.VestingCollision.NumberOfCollisions), ""})
+	}
+	if manifest.CreatedAccounts != nil {
+		table.Rows = append(table.Rows, []string{"Created accounts", fmt.Sprintf("%d", manifest.CreatedAccounts.NumberOfCreations), ""})
+	}
+	if manifest.Reconciliation != nil && manifest.Reconciliation.Transfers != nil {
+		table.Rows = append(table.Rows, []string{"Reconciliation transfers", fmt.Sprintf("%d", manifest.Reconciliation.Transfers.NumberOfTransfers), manifest.Reconciliation.Transfers.AggregatedTransferredAmount.String()})
+	}
+	if manifest.Reconciliation != nil && manifest.Reconciliation.ContractState != nil {
+		table.Rows = append(table.Rows, []string{"Reconciliation contract balances", fmt.Sprintf("%d", manifest.Reconciliation.ContractState.NumberOfBalanceRecords), manifest.Reconciliation.ContractState.AggregatedBalancesAmount.String()})
+	}
+
+	return reportSection{Title: "Totals per section", Tables: []reportTable{table}}
+}
+
+// coinsTotalAmount returns sum of amounts across all denominations, it is used exclusively for ordering purposes.
+func coinsTotalAmount(coins sdk.Coins) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, coin := range coins {
+		total = total.Add(coin.Amount)
+	}
+	return total
+}
+
+func topBalanceMovements(movements []app.UpgradeBalanceMovement, topN int, amountOf func(movement *app.UpgradeBalanceMovement) sdk.Coins) []app.UpgradeBalanceMovement {
+	sorted := make([]app.UpgradeBalanceMovement, len(movements))
+	copy(sorted, movements)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return coinsTotalAmount(amountOf(&sorted[i])).GT(coinsTotalAmount(amountOf(&sorted[j])))
+	})
+
+	if topN >= 0 && len(sorted) > topN {
+		sorted = sorted[:topN]
+	}
+
+	return sorted
+}
+
+func reportTopMovementsSection(manifest *app.UpgradeManifest, topN int) reportSection {
+	section := reportSection{Title: fmt.Sprintf("Top %d movements", topN)}
+
+	if manifest.Migration != nil {
+		table := reportTable{Caption: "Largest migrations (minted on destination chain):", Header: []string{"From", "To", "Source balance", "Destination balance", "Memo"}}
+		for _, migration := range topBalanceMovements(manifest.Migration.Migrations, topN, func(m *app.UpgradeBalanceMovement) sdk.Coins { return m.DestBalance }) {
+			table.Rows = append(table.Rows, []string{migration.From, migration.To, migration.SourceBalance.String(), migration.DestBalance.String(), migration.Memo})
+		}
+		section.Tables = append(section.Tables, table)
+	}
+
+	if manifest.MoveGenesisBalance != nil {
+		table := reportTable{Caption: "Largest genesis balance movements (source chain):", Header: []string{"From", "To", "Amount", "Memo"}}
+		for _, movement := range topBalanceMovements(manifest.MoveGenesisBalance.Movements, topN, func(m *app.UpgradeBalanceMovement) sdk.Coins { return m.DestBalance }) {
+			table.Rows = append(table.Rows, []string{movement.From, movement.To, movement.DestBalance.String(), movement.Memo})
+		}
+		section.Tables = append(section.Tables, table)
+	}
+
+	return section
+}
+
+type validatorMappingOutcome struct {
+	OriginalValidator  string
+	ConfiguredTarget   string
+	NewValidator       string
+	NumberOfDelegation int
+	OriginalTokens     sdk.Int
+	NewTokens          sdk.Int
+}
+
+func reportValidatorMappingSection(manifest *app.UpgradeManifest, networkInfo *app.NetworkConfig) reportSection {
+	section := reportSection{Title: "Validator mapping outcomes"}
+
+	validatorsMap := app.NewOrderedMap[string, string]()
+	if networkInfo.CudosMerge != nil {
+		validatorsMap = app.NewOrderedMapFromPairs(networkInfo.CudosMerge.ValidatorsMap)
+	}
+
+	if manifest.Delegate == nil {
+		section.Paragraphs = append(section.Paragraphs, "No delegations have been created.")
+		return section
+	}
+
+	outcomes := app.NewOrderedMap[string, *validatorMappingOutcome]()
+	for _, delegation := range manifest.Delegate.Delegations {
+		key := delegation.OriginalValidator + "/" + delegation.NewValidator
+		configuredTarget, _ := validatorsMap.Get(delegation.OriginalValidator)
+		outcome, _ := outcomes.GetOrSetDefault(key, &validatorMappingOutcome{
+			OriginalValidator: delegation.OriginalValidator,
+			ConfiguredTarget:  configuredTarget,
+			NewValidator:      delegation.NewValidator,
+			OriginalTokens:    sdk.ZeroInt(),
+			NewTokens:         sdk.ZeroInt(),
+		})
+		outcome.NumberOfDelegation++
+		outcome.OriginalTokens = outcome.OriginalTokens.Add(delegation.OriginalTokens)
+		outcome.NewTokens = outcome.NewTokens.Add(delegation.NewTokens)
+	}
+
+	table := reportTable{Header: []string{"Source validator", "Configured target", "Actual target", "Outcome", "Delegations", "Source tokens", "Destination tokens"}}
+	for _, key := range outcomes.Keys() {
+		outcome := outcomes.MustGet(key)
+
+		outcomeStr := "mapped"
+		if outcome.ConfiguredTarget == "" {
+			outcomeStr = "not in map (backup validator)"
+		} else if outcome.ConfiguredTarget != outcome.NewValidator {
+			outcomeStr = "target unavailable (backup validator)"
+		}
+
+		table.Rows = append(table.Rows, []string{
+			outcome.OriginalValidator,
+			outcome.ConfiguredTarget,
+			outcome.NewValidator,
+			outcomeStr,
+			fmt.Sprintf("%d", outcome.NumberOfDelegation),
+			outcome.OriginalTokens.String(),
+			outcome.NewTokens.String(),
+		})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func reportContractsSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Contract changes"}
+
+	if manifest.Contracts == nil {
+		section.Paragraphs = append(section.Paragraphs, "No contracts have been changed.")
+		return section
+	}
+
+	table := reportTable{Header: []string{"Contract", "Change", "From", "To"}}
+	for _, contract := range manifest.Contracts.StateCleaned {
+		table.Rows = append(table.Rows, []string{contract, "state cleaned", "", ""})
+	}
+	for _, update := range manifest.Contracts.AdminUpdated {
+		change := "admin"
+		if update.Kind != "" {
+			change = update.Kind
+		}
+		table.Rows = append(table.Rows, []string{update.Address, change, update.From, update.To})
+	}
+	for _, update := range manifest.Contracts.LabelUpdated {
+		table.Rows = append(table.Rows, []string{update.Address, "label", update.From, update.To})
+	}
+	for _, update := range manifest.Contracts.VersionUpdated {
+		table.Rows = append(table.Rows, []string{update.Address, "cw2 version", cw2VersionString(update.From), cw2VersionString(update.To)})
+	}
+	for _, migration := range manifest.Contracts.Migrated {
+		table.Rows = append(table.Rows, []string{migration.Contract, "code migration", fmt.Sprintf("code %d", migration.FromCodeID), fmt.Sprintf("code %d", migration.ToCodeID)})
+	}
+	section.Tables = append(section.Tables, table)
+
+	if len(manifest.Contracts.Operations) > 0 {
+		operationsTable := reportTable{Caption: "Contract operations:", Header: []string{"Source", "Index", "Type", "Contract", "Key", "From", "To", "Deleted keys"}}
+		for _, operation := range manifest.Contracts.Operations {
+			operationsTable.Rows = append(operationsTable.Rows, []string{
+				operation.Source,
+				fmt.Sprintf("%d", operation.Index),
+				operation.Type,
+				operation.Contract,
+				operation.Key,
+				operation.From,
+				operation.To,
+				fmt.Sprintf("%d", operation.NumberOfDeletedKeys),
+			})
+		}
+		section.Tables = append(section.Tables, operationsTable)
+	}
+
+	if len(manifest.Contracts.StatePruned) > 0 {
+		pruningTable := reportTable{Caption: "State pruning:", Header: []string{"Contract", "Namespace", "Deleted keys", "Kept keys"}}
+		for _, pruning := range manifest.Contracts.StatePruned {
+			for _, namespace := range pruning.Namespaces {
+				pruningTable.Rows = append(pruningTable.Rows, []string{
+					pruning.Contract,
+					namespace.Namespace,
+					fmt.Sprintf("%d", namespace.NumberOfDeletedKeys),
+					fmt.Sprintf("%d", namespace.NumberOfKeptKeys),
+				})
+			}
+		}
+		section.Tables = append(section.Tables, pruningTable)
+	}
+
+	return section
+}
+
+func reportContractRoutingSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Contract balance routing"}
+
+	if manifest.ContractRouting == nil {
+		section.Paragraphs = append(section.Paragraphs, "No contract balances have been routed.")
+		return section
+	}
+
+	section.Paragraphs = append(section.Paragraphs, "Amounts are in source chain denominations.")
+	table := reportTable{Header: []string{"Contract", "Route", "Recipients", "Balance", "Delegations", "Gov deposits"}}
+	for _, route := range manifest.ContractRouting.Contracts {
+		recipients := make([]string, len(route.Recipients))
+		for i, recipient := range route.Recipients {
+			recipients[i] = recipient.Address
+		}
+		table.Rows = append(table.Rows, []string{
+			route.Contract,
+			route.Route,
+			strings.Join(recipients, ", "),
+			route.RoutedBalance.String(),
+			route.RoutedDelegation.String(),
+			route.RoutedGovDeposit.String(),
+		})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func cw2VersionString(version *app.CW2ContractVersion) string {
+	if version == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", version.Contract, version.Version)
+}
+
+func reportParamsSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Parameter changes"}
+
+	if manifest.MaxValidatorsChange == nil {
+		section.Paragraphs = append(section.Paragraphs, "No parameters have been changed.")
+		return section
+	}
+
+	section.Tables = append(section.Tables, reportTable{
+		Header: []string{"Parameter", "Original value", "New value"},
+		Rows: [][]string{
+			{"staking max_validators", fmt.Sprintf("%d", manifest.MaxValidatorsChange.OriginalVal), fmt.Sprintf("%d", manifest.MaxValidatorsChange.NewVal)},
+		},
+	})
+
+	return section
+}
+
+func reportLedgerSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Ledger"}
+
+	if manifest.Ledger == nil {
+		section.Paragraphs = append(section.Paragraphs, "Ledger is not recorded in the manifest.")
+		return section
+	}
+
+	section.Paragraphs = append(section.Paragraphs, fmt.Sprintf("Ledger contains %d entries, they are listed in the manifest.", manifest.Ledger.NumberOfEntries))
+
+	table := reportTable{Header: []string{"Domain", "Entries", "Total amount", "Issued", "Retired"}}
+	for _, totals := range manifest.Ledger.Totals {
+		table.Rows = append(table.Rows, []string{string(totals.Domain), fmt.Sprintf("%d", totals.NumberOfEntries), totals.TotalAmount.String(), totals.Issued.String(), totals.Retired.String()})
+	}
+	section.Tables = append(section.Tables, table)
+
+	section.Tables = append(section.Tables, reportTable{
+		Caption: "Conversions:",
+		Header:  []string{"Property", "Value"},
+		Rows: [][]string{
+			{"Converted source amount", manifest.Ledger.TotalConvertedSourceAmount.String()},
+			{"Converted destination amount", manifest.Ledger.TotalConvertedDestAmount.String()},
+			{"Rounding remainder", manifest.Ledger.TotalRoundingRemainder.String()},
+			{"Capped amount", manifest.Ledger.TotalCappedAmount.String()},
+		},
+	})
+
+	return section
+}
+
+func reportConversionTiersSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Conversion tiers"}
+
+	if manifest.ConversionTiers == nil {
+		section.Paragraphs = append(section.Paragraphs, "All accounts have been converted with default conversion constants.")
+		return section
+	}
+
+	section.Paragraphs = append(section.Paragraphs, fmt.Sprintf("Commission has been adjusted by %s.", manifest.ConversionTiers.Adjustment.String()))
+
+	table := reportTable{Header: []string{"Tier", "Accounts", "Capped accounts", "Default converted amount", "Tier converted amount"}}
+	for _, tier := range manifest.ConversionTiers.Tiers {
+		table.Rows = append(table.Rows, []string{tier.Name, fmt.Sprintf("%d", tier.NumberOfAccounts), fmt.Sprintf("%d", tier.NumberOfCappedAccounts), tier.DefaultConvertedAmount.String(), tier.TierConvertedAmount.String()})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func reportRoundingSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Rounding remainders"}
+
+	remainders := manifest.RoundingRemainders
+	if remainders == nil {
+		section.Paragraphs = append(section.Paragraphs, "Rounding remainders are not recorded in the manifest.")
+		return section
+	}
+
+	section.Tables = append(section.Tables, reportTable{
+		Header: []string{"Property", "Value"},
+		Rows: [][]string{
+			{"Policy", remainders.Policy},
+			{"Conversions with remainder", fmt.Sprintf("%d", remainders.NumberOfRemainders)},
+			{"Aggregated remainder", remainders.AggregatedRemainder.String()},
+			{"Distributed amount", remainders.DistributedAmount.String()},
+			{"Residual remainder", remainders.ResidualRemainder.String()},
+			{"Pool address", remainders.PoolAddress},
+			{"Rounded up accounts", fmt.Sprintf("%d", remainders.NumberOfAdjustments)},
+			{"Excluded vesting accounts", fmt.Sprintf("%d", remainders.NumberOfExcludedVesting)},
+		},
+	})
+
+	return section
+}
+
+func reportDustSection(manifest *app.UpgradeManifest, topN int) reportSection {
+	section := reportSection{Title: "Dust accounts"}
+
+	dust := manifest.Dust
+	if dust == nil {
+		section.Paragraphs = append(section.Paragraphs, "No accounts have been handled as dust.")
+		return section
+	}
+
+	section.Tables = append(section.Tables, reportTable{
+		Header: []string{"Property", "Value"},
+		Rows: [][]string{
+			{"Threshold", dust.Threshold.String()},
+			{"Policy", dust.Policy},
+			{"Destination address", dust.DestAddress},
+			{"Accounts", fmt.Sprintf("%d", dust.NumberOfAccounts)},
+			{"Aggregated source balance", dust.AggregatedSourceBalance.String()},
+			{"Aggregated amount", dust.AggregatedAmount.String()},
+			{"Burned amount", dust.BurnedAmount.String()},
+		},
+	})
+
+	accounts := make([]app.UpgradeDustAccount, len(dust.Accounts))
+	copy(accounts, dust.Accounts)
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return accounts[i].ConvertedAmount.GT(accounts[j].ConvertedAmount)
+	})
+	if topN >= 0 && len(accounts) > topN {
+		accounts = accounts[:topN]
+	}
+
+	table := reportTable{Caption: fmt.Sprintf("Top %d dust accounts:", topN), Header: []string{"Address", "Source balance", "Converted amount"}}
+	for _, account := range accounts {
+		table.Rows = append(table.Rows, []string{account.Address, account.SourceBalance.String(), account.ConvertedAmount.String()})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+type vestingScheduleSummary struct {
+	NumberOfAccounts int
+	OriginalVesting  sdk.Coins
+}
+
+func reportVestingSchedulesSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Vesting schedules"}
+
+	if manifest.VestingSchedules == nil {
+		section.Paragraphs = append(section.Paragraphs, "No vesting schedules are recorded in the manifest.")
+		return section
+	}
+
+	summaries := app.NewOrderedMap[string, *vestingScheduleSummary]()
+	for _, schedule := range manifest.VestingSchedules.Schedules {
+		name := schedule.Schedule
+		if name == "" {
+			name = "default"
+		}
+		summary, _ := summaries.GetOrSetDefault(name+"/"+schedule.Type, &vestingScheduleSummary{OriginalVesting: sdk.NewCoins()})
+		summary.NumberOfAccounts++
+		summary.OriginalVesting = summary.OriginalVesting.Add(schedule.OriginalVesting...)
+	}
+
+	table := reportTable{Header: []string{"Schedule", "Vesting type", "Accounts", "Original vesting"}}
+	for i := range summaries.Iterate() {
+		nameAndType := strings.SplitN(i.Key, "/", 2)
+		table.Rows = append(table.Rows, []string{nameAndType[0], nameAndType[1], fmt.Sprintf("%d", i.Value.NumberOfAccounts), i.Value.OriginalVesting.String()})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func reportGovDepositsSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Gov deposit refunds"}
+
+	if manifest.GovDeposits == nil {
+		section.Paragraphs = append(section.Paragraphs, "No gov deposits have been refunded.")
+		return section
+	}
+
+	section.Paragraphs = append(section.Paragraphs, fmt.Sprintf("%d deposits refunded in total of %s (source chain denominations).", manifest.GovDeposits.NumberOfRefunds, manifest.GovDeposits.AggregatedRefundedAmount.String()))
+
+	table := reportTable{Header: []string{"Proposal", "Status", "Refunds", "Total refunded"}}
+	for _, proposal := range manifest.GovDeposits.Proposals {
+		table.Rows = append(table.Rows, []string{fmt.Sprintf("%d", proposal.ProposalID), proposal.Status, fmt.Sprintf("%d", len(proposal.Refunds)), proposal.TotalRefunded.String()})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func reportPubkeysSection(manifest *app.UpgradeManifest, topN int) reportSection {
+	section := reportSection{Title: "Account pubkeys"}
+
+	if manifest.Pubkeys == nil {
+		section.Paragraphs = append(section.Paragraphs, "No account pubkeys are recorded in the manifest.")
+		return section
+	}
+
+	decodedTable := reportTable{Caption: "Pubkeys decoded from source accounts:", Header: []string{"Type", "Accounts"}}
+	for _, decoded := range manifest.Pubkeys.Decoded {
+		decodedTable.Rows = append(decodedTable.Rows, []string{decoded.Type, fmt.Sprintf("%d", decoded.NumberOfAccounts)})
+	}
+	section.Tables = append(section.Tables, decodedTable)
+
+	if manifest.Pubkeys.NumberOfUnsupported > 0 {
+		unsupported := manifest.Pubkeys.Unsupported
+		if topN >= 0 && len(unsupported) > topN {
+			unsupported = unsupported[:topN]
+		}
+
+		unsupportedTable := reportTable{
+			Caption: fmt.Sprintf("Dropped pubkeys, %d in total (first %d listed):", manifest.Pubkeys.NumberOfUnsupported, len(unsupported)),
+			Header:  []string{"Address", "Type", "Reason"},
+		}
+		for _, pubkey := range unsupported {
+			unsupportedTable.Rows = append(unsupportedTable.Rows, []string{pubkey.Address, pubkey.Type, pubkey.Reason})
+		}
+		section.Tables = append(section.Tables, unsupportedTable)
+	}
+
+	return section
+}
+
+func reportSupplySection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Supply verification"}
+
+	if supply := manifest.SupplyVerification; supply != nil {
+		section.Tables = append(section.Tables, reportTable{
+			Header: []string{"Property", "Value"},
+			Rows: [][]string{
+				{"Destination supply before", supply.DestinationSupplyBefore.String()},
+				{"Destination supply after", supply.DestinationSupplyAfter.String()},
+				{"Expected minted supply", supply.ExpectedMintedSupply.String()},
+				{"Minted supply", supply.MintedSupply.String()},
+				{"Max tolerated difference", supply.MaxToleratedDifference.String()},
+			},
+		})
+	} else {
+		section.Paragraphs = append(section.Paragraphs, "Supply verification data is not present in the manifest.")
+	}
+
+	if manifest.SupplyBreakdown != nil {
+		section.Tables = append(section.Tables,
+			supplyBreakdownTable("Source supply breakdown:", manifest.SupplyBreakdown.Source),
+			supplyBreakdownTable("Destination supply breakdown:", manifest.SupplyBreakdown.Destination),
+		)
+	}
+
+	return section
+}
+
+func supplyBreakdownTable(caption string, lines []*app.UpgradeSupplyBreakdownLine) reportTable {
+	table := reportTable{Caption: caption, Header: []string{"Line", "Denom", "Expected", "Actual", "Difference"}}
+	for _, line := range lines {
+		table.Rows = append(table.Rows, []string{line.Name, line.Denom, formatSupplyBreakdownValue(line.Expected), formatSupplyBreakdownValue(line.Actual), formatSupplyBreakdownValue(line.Difference)})
+	}
+	return table
+}
+
+func reportToleranceChecksSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Tolerance checks"}
+
+	if len(manifest.ToleranceChecks) == 0 {
+		section.Paragraphs = append(section.Paragraphs, "No tolerance checks are recorded in the manifest.")
+		return section
+	}
+
+	table := reportTable{Header: []string{"Check", "Remaining balance", "Max tolerated", "Result"}}
+	for _, check := range manifest.ToleranceChecks {
+		result := "PASSED"
+		if !check.Passed {
+			result = "FAILED"
+		}
+		table.Rows = append(table.Rows, []string{check.Name, check.Balance, check.MaxTolerated.String(), result})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func reportAuditSection(manifest *app.UpgradeManifest) reportSection {
+	section := reportSection{Title: "Post-upgrade audit"}
+
+	if manifest.Audit == nil {
+		section.Paragraphs = append(section.Paragraphs, "Audit result is not recorded in the manifest.")
+		return section
+	}
+
+	if manifest.Audit.Overridden {
+		section.Paragraphs = append(section.Paragraphs, "Audit failed and the upgrade proceeded due to operator override.")
+	}
+
+	table := reportTable{Header: []string{"Check", "Result", "Details"}}
+	for _, check := range manifest.Audit.Checks {
+		result := "PASSED"
+		if !check.Passed {
+			result = "FAILED"
+		}
+		table.Rows = append(table.Rows, []string{check.Name, result, check.Details})
+	}
+	section.Tables = append(section.Tables, table)
+
+	return section
+}
+
+func markdownCell(val string) string {
+	return strings.ReplaceAll(val, "|", "\\|")
+}
+
+func renderMarkdownReport(report *upgradeReport) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", report.Title))
+
+	for _, section := range report.Sections {
+		sb.WriteString(fmt.Sprintf("## %s\n\n", section.Title))
+
+		for _, paragraph := range section.Paragraphs {
+			sb.WriteString(fmt.Sprintf("%s\n\n", paragraph))
+		}
+
+		for _, table := range section.Tables {
+			if table.Caption != "" {
+				sb.WriteString(fmt.Sprintf("%s\n\n", table.Caption))
+			}
+
+			sb.WriteString("| " + strings.Join(table.Header, " | ") + " |\n")
+			sb.WriteString("|" + strings.Repeat(" --- |", len(table.Header)) + "\n")
+			for _, row := range table.Rows {
+				cells := make([]string, len(row))
+				for j, cell := range row {
+					cells[j] = markdownCell(cell)
+				}
+				sb.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func renderHTMLReport(report *upgradeReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(report.Title)))
+	sb.WriteString("<style>table{border-collapse:collapse}th,td{border:1px solid #999;padding:2px 6px;font-family:monospace}</style>\n")
+	sb.WriteString("</head>\n<body>\n")
+	sb.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(report.Title)))
+
+	for _, section := range report.Sections {
+		sb.WriteString(fmt.Sprintf("<h2>%s</h2>\n", html.EscapeString(section.Title)))
+
+		for _, paragraph := range section.Paragraphs {
+			sb.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(paragraph)))
+		}
+
+		for _, table := range section.Tables {
+			if table.Caption != "" {
+				sb.WriteString(fmt.Sprintf("<p>%s</p>\n", html.EscapeString(table.Caption)))
+			}
+
+			sb.WriteString("<table>\n<tr>")
+			for _, header := range table.Header {
+				sb.WriteString(fmt.Sprintf("<th>%s</th>", html.EscapeString(header)))
+			}
+			sb.WriteString("</tr>\n")
+			for _, row := range table.Rows {
+				sb.WriteString("<tr>")
+				for _, cell := range row {
+					sb.WriteString(fmt.Sprintf("<td>%s</td>", html.EscapeString(cell)))
+				}
+				sb.WriteString("</tr>\n")
+			}
+			sb.WriteString("</table>\n")
+		}
+	}
+
+	sb.WriteString("</body>\n</html>\n")
+
+	return sb.String()
+}
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/fetchai/fetchd/app"
+	"github.com/spf13/cobra"
+	"os"
+)
+
+const (
+	FlagReconciliationContract = "contract"
+	FlagEthPrivateKeyFile      = "eth-private-key-file"
+)
+
+func utilReconciliationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "reconciliation",
+		Short:                      "Reconciliation commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	AddCommandVerifyReconciliationCSV(cmd)
+
+	return cmd
+}
+
+func AddCommandVerifyReconciliationCSV(reconciliationCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "verify-csv [reconciliation_csv_file_path]",
+		Short: "Verifies consistency of the reconciliation data CSV file",
+		Long: `This command verifies every record of the reconciliation data CSV file.
+Ethereum and fetch addresses are derived from the public key column and compared with the address columns, and the total amount column is checked to be equal to the sum of staked and unstaked amounts.
+Inconsistent records are reported and rejected during the upgrade, so they can not move any funds.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			csvData, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("error reading file: %w", err)
+			}
+
+			records, err := csv.NewReader(bytes.NewReader(csvData)).ReadAll()
+			if err != nil {
+				return fmt.Errorf("error parsing reconciliation data: %w", err)
+			}
+
+			issues := app.ValidateReconciliationRecords(records, app.AccountAddressPrefix)
+			for _, issue := range issues {
+				err = ctx.PrintString(fmt.Sprintf("row %d (%s, %s): %s\n", issue.Row, issue.EthAddr, issue.FetchAddr, issue.Reason))
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(issues) > 0 {
+				return fmt.Errorf("%d of %d records are inconsistent", len(issues), len(records))
+			}
+
+			return ctx.PrintString(fmt.Sprintf("All %d records are consistent.\n", len(records)))
+		},
+	}
+
+	reconciliationCmd.AddCommand(cmd)
+}
+
+func txReconciliationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "reconciliation",
+		Short:                      "Reconciliation contract transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	AddCommandReconciliationClaim(cmd)
+
+	return cmd
+}
+
+func queryReconciliationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "reconciliation",
+		Short:                      "Reconciliation contract query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	AddCommandReconciliationBalance(cmd)
+
+	return cmd
+}
+
+func queryReconciliationRawState(clientCtx client.Context, cmd *cobra.Command, contractAddr string, key []byte) ([]byte, error) {
+	queryClient := wasmtypes.NewQueryClient(clientCtx)
+	res, err := queryClient.RawContractState(cmd.Context(), &wasmtypes.QueryRawContractStateRequest{
+		Address:   contractAddr,
+		QueryData: key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reconciliation contract state: %w", err)
+	}
+	return res.Data, nil
+}
+
+func getReconciliationContractAddr(cmd *cobra.Command, chainID string) (string, error) {
+	contractAddr, err := cmd.Flags().GetString(FlagReconciliationContract)
+	if err != nil {
+		return "", err
+	}
+	if contractAddr == "" {
+		var exists bool
+		if contractAddr, exists = app.GetReconciliationContractAddr(chainID); !exists {
+			return "", fmt.Errorf("reconciliation contract address is not known for chain id %q, use --%s flag", chainID, FlagReconciliationContract)
+		}
+	}
+	return contractAddr, nil
+}
+
+func AddCommandReconciliationBalance(reconciliationCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "balance [eth_address]",
+		Short: "Queries reconciled balance of the Ethereum address held by the reconciliation contract",
+		Long: `This command reads the outstanding balance of the Ethereum address directly from the raw state of the reconciliation contract, as it was written by the network merge upgrade.
+The upgrade leaves the reconciliation contract paused, so the balance can not be claimed until the contract admin unpauses the contract. Whether the contract is paused is printed together with the balance.
+If the --contract flag is not given, the reconciliation contract address is taken from the built-in network config of the chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := getReconciliationContractAddr(cmd, clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			ethAddr := args[0]
+			balanceKey, err := app.ReconciliationBalanceKey(ethAddr)
+			if err != nil {
+				return err
+			}
+			balanceRecord, err := queryReconciliationRawState(clientCtx, cmd, contractAddr, balanceKey)
+			if err != nil {
+				return err
+			}
+			balance, err := app.ParseReconciliationBalance(balanceRecord)
+			if err != nil {
+				return err
+			}
+
+			stateRecord, err := queryReconciliationRawState(clientCtx, cmd, contractAddr, app.ReconciliationStateKey())
+			if err != nil {
+				return err
+			}
+			state, err := app.ParseReconciliationState(stateRecord)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("Outstanding balance of %s: %s\nContract %s paused: %t\n", ethAddr, balance.String(), contractAddr, state.Paused))
+		},
+	}
+
+	cmd.Flags().String(FlagReconciliationContract, "", "Address of the reconciliation contract, defaults to the contract of the built-in network config")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	reconciliationCmd.AddCommand(cmd)
+}
+
+func AddCommandReconciliationClaim(reconciliationCmd *cobra.Command) {
+	cmd := &cobra.Command{
+		Use:   "claim --from [recipient] --eth-private-key-file [path]",
+		Short: "Claims reconciled balance of the Ethereum address from the reconciliation contract",
+		Long: `This command claims the outstanding balance of the Ethereum address held by the reconciliation contract.
+The Ethereum address is derived from the secp256k1 private key read from the given file (hex encoded, with or without 0x prefix).
+Ownership of the Ethereum address is proven by EIP-191 (personal_sign) signature of a message which binds the claim to the recipient (--from) address, the contract and the chain ID.
+The outstanding balance and the paused state are read directly from the raw contract state before the transaction is broadcast, and the command fails if there is nothing to claim or the contract is paused.
+If the --contract flag is not given, the reconciliation contract address is taken from the built-in network config of the chain.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := getReconciliationContractAddr(cmd, clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			privKeyFile, err := cmd.Flags().GetString(FlagEthPrivateKeyFile)
+			if err != nil {
+				return err
+			}
+			privKeyHex, err := os.ReadFile(privKeyFile)
+			if err != nil {
+				return fmt.Errorf("error reading private key file: %w", err)
+			}
+			privKey, ethAddr, err := app.ParseEthPrivateKey(string(privKeyHex))
+			if err != nil {
+				return err
+			}
+
+			balanceKey, err := app.ReconciliationBalanceKey(ethAddr)
+			if err != nil {
+				return err
+			}
+			balanceRecord, err := queryReconciliationRawState(clientCtx, cmd, contractAddr, balanceKey)
+			if err != nil {
+				return err
+			}
+			balance, err := app.ParseReconciliationBalance(balanceRecord)
+			if err != nil {
+				return err
+			}
+			if balance.IsZero() {
+				return fmt.Errorf("eth address %s has no outstanding balance in reconciliation contract %s", ethAddr, contractAddr)
+			}
+
+			stateRecord, err := queryReconciliationRawState(clientCtx, cmd, contractAddr, app.ReconciliationStateKey())
+			if err != nil {
+				return err
+			}
+			state, err := app.ParseReconciliationState(stateRecord)
+			if err != nil {
+				return err
+			}
+			if state.Paused {
+				return fmt.Errorf("reconciliation contract %s is paused, balance %s of %s can not be claimed yet", contractAddr, balance.String(), ethAddr)
+			}
+
+			err = clientCtx.PrintString(fmt.Sprintf("Outstanding balance of %s: %s\n", ethAddr, balance.String()))
+			if err != nil {
+				return err
+			}
+
+			claimMsg, err := app.NewReconciliationClaimMsg(privKey, ethAddr, clientCtx.GetFromAddress().String(), contractAddr, clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+			claimMsgJSON, err := json.Marshal(claimMsg)
+			if err != nil {
+				return err
+			}
+
+			msg := &wasmtypes.MsgExecuteContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: contractAddr,
+				Msg:      claimMsgJSON,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReconciliationContract, "", "Address of the reconciliation contract, defaults to the contract of the built-in network config")
+	cmd.Flags().String(FlagEthPrivateKeyFile, "", "Path to file with hex encoded secp256k1 private key of the Ethereum address")
+	_ = cmd.MarkFlagRequired(FlagEthPrivateKeyFile)
+	flags.AddTxFlagsToCmd(cmd)
+
+	reconciliationCmd.AddCommand(cmd)
+}
@@ -96,8 +96,14 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 func initAppConfig() (string, interface{}) {
 	// Optionally allow the chain developer to overwrite the SDK's default
 	// server config.
+	type NetworkMergeConfig struct {
+		AuditOverride bool `mapstructure:"audit-override"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		NetworkMerge NetworkMergeConfig `mapstructure:"network-merge"`
 	}
 
 	srvCfg := serverconfig.DefaultConfig()
@@ -119,7 +125,20 @@ func initAppConfig() (string, interface{}) {
 		Config: *srvCfg,
 	}
 
-	return serverconfig.DefaultConfigTemplate, customAppConfig
+	customAppTemplate := serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                       Network Merge Configuration                       ###
+###############################################################################
+
+[network-merge]
+
+# Proceed with the network merge upgrade even if its post-upgrade audit fails.
+# By default the node halts at the upgrade height. Enable ONLY after coordination
+# with other validators, since all nodes must take the same decision.
+audit-override = {{ .NetworkMerge.AuditOverride }}
+`
+
+	return customAppTemplate, customAppConfig
 }
 
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
@@ -176,6 +195,7 @@ func queryCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
+	cmd.AddCommand(queryReconciliationCommand())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -203,6 +223,7 @@ func txCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddTxCommands(cmd)
+	cmd.AddCommand(txReconciliationCommand())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -253,6 +274,8 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		cast.ToString(appOpts.Get(FlagCudosMigrationConfigPath)),
 		cast.ToString(appOpts.Get(FlagCudosGenesisSha256)),
 		cast.ToString(appOpts.Get(FlagCudosMigrationConfigSha256)),
+		cast.ToString(appOpts.Get(FlagCudosReconciliationDataPath)),
+		cast.ToString(appOpts.Get(FlagCudosReconciliationDataSha256)),
 		a.encCfg,
 		app.GetEnabledProposals(),
 		appOpts,
@@ -297,6 +320,8 @@ func (a appCreator) appExport(
 			"",
 			"",
 			"",
+			"",
+			"",
 			a.encCfg,
 			app.GetEnabledProposals(),
 			appOpts,
@@ -319,6 +344,8 @@ func (a appCreator) appExport(
 			"",
 			"",
 			"",
+			"",
+			"",
 			a.encCfg,
 			app.GetEnabledProposals(),
 			appOpts,
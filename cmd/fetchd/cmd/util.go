@@ -44,6 +44,8 @@ func utilCommand() *cobra.Command {
 		utilJsonCommand(),
 		utilAddressCommand(),
 		utilNetworkMergeCommand(),
+		utilReconciliationCommand(),
+		utilContractCommand(),
 	)
 
 	return cmd